@@ -0,0 +1,22 @@
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+# List every human member holding the Moderator role
+data "discord_guild_members" "moderators" {
+  guild_id = local.guild_id
+  role_id  = "987654321098765432" # Replace with your role ID
+  bot      = false
+}
+
+# Search members by username or nickname prefix
+data "discord_guild_members" "search" {
+  guild_id = local.guild_id
+  query    = "alex"
+}
+
+output "moderator_ids" {
+  value = data.discord_guild_members.moderators.members[*].user_id
+}
@@ -0,0 +1,22 @@
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  channel_id = "123456789012345678" # Replace with your channel ID
+}
+
+# Read a message posted by someone else, without importing it
+data "discord_message" "rules" {
+  channel_id = local.channel_id
+  id         = "234567890123456789" # Replace with your message ID
+}
+
+# Seed reactions on it
+resource "discord_message_reaction" "accept" {
+  channel_id = data.discord_message.rules.channel_id
+  message_id = data.discord_message.rules.id
+  emoji      = "✅"
+}
+
+output "rules_author" {
+  value = data.discord_message.rules.author_username
+}
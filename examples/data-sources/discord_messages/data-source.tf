@@ -0,0 +1,23 @@
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  channel_id = "123456789012345678" # Replace with your channel ID
+}
+
+# The 200 latest messages of a channel, read in two pages
+data "discord_messages" "recent" {
+  channel_id = local.channel_id
+  limit      = 200
+}
+
+# Release announcements by one author, from the messages after a given one
+data "discord_messages" "releases" {
+  channel_id    = local.channel_id
+  after         = "234567890123456789" # Replace with a message ID
+  author_id     = "345678901234567890" # Replace with a user ID
+  content_regex = "^Release v\\d+"
+}
+
+output "latest_release_url" {
+  value = try(data.discord_messages.releases.messages[0].attachments[0].url, null)
+}
@@ -0,0 +1,27 @@
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+# List every role in the guild
+data "discord_roles" "all" {
+  guild_id = local.guild_id
+}
+
+# Find roles managed by bot integrations
+data "discord_roles" "bots" {
+  guild_id = local.guild_id
+  managed  = true
+}
+
+# Find moderator roles by name and permission
+data "discord_roles" "moderators" {
+  guild_id   = local.guild_id
+  name_regex = "(?i)^mod"
+  permission = "manage_messages"
+}
+
+output "bot_role_ids" {
+  value = { for r in data.discord_roles.bots.roles : r.name => r.id if r.tags != null && r.tags.bot_id != null }
+}
@@ -0,0 +1,7 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Relay messages published in an announcement channel to another channel.
+resource "discord_channel_follower" "example" {
+  channel_id         = "123456789012345678" # Announcement channel (type 5) to follow
+  webhook_channel_id = discord_channel.news_feed.id
+}
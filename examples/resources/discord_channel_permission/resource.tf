@@ -23,3 +23,13 @@ data "discord_permission" "allow" {
 data "discord_permission" "deny" {
   manage_messages = true
 }
+
+# Alternatively, use permission names directly
+resource "discord_channel_permission" "named" {
+  channel_id   = "123456789012345678" # Replace with your channel ID
+  overwrite_id = discord_role.example.id
+  type         = 0
+
+  allow_permissions = ["view_channel", "send_messages", "read_message_history"]
+  deny_permissions  = ["manage_messages"]
+}
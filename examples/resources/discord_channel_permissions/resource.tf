@@ -0,0 +1,24 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Authoritatively manage every permission overwrite on a channel.
+# Overwrites not listed here are removed from the channel.
+resource "discord_channel_permissions" "example" {
+  channel_id = "123456789012345678" # Replace with your channel ID
+
+  overwrites = [
+    {
+      overwrite_id = "123456789012345678" # @everyone role (same ID as the guild)
+      type         = 0
+      deny         = data.discord_permission.view.allow_bits
+    },
+    {
+      overwrite_id = discord_role.moderator.id
+      type         = 0
+      allow        = data.discord_permission.view.allow_bits
+    },
+  ]
+}
+
+data "discord_permission" "view" {
+  view_channel = true
+}
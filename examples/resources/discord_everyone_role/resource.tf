@@ -0,0 +1,15 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Restrict the baseline permissions every member receives.
+resource "discord_everyone_role" "example" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+
+  permission_names = [
+    "view_channel",
+    "send_messages",
+    "read_message_history",
+    "add_reactions",
+    "connect",
+    "speak",
+  ]
+}
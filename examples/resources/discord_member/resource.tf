@@ -0,0 +1,11 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Manage a guild member's nickname, timeout and verification bypass
+resource "discord_member" "example" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+  user_id  = "987654321098765432" # Replace with your user ID
+
+  nick                  = "Helper"
+  timeout_until         = "2025-01-02T15:04:05Z" # At most 28 days in the future
+  bypasses_verification = true
+}
@@ -0,0 +1,8 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Assign a single role to a guild member without touching their other roles
+resource "discord_member_role" "example" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+  user_id  = "987654321098765432" # Replace with your user ID
+  role_id  = discord_role.member.id
+}
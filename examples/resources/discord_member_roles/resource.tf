@@ -11,3 +11,27 @@ resource "discord_member_roles" "example" {
     discord_role.member.id,
   ]
 }
+
+# Only manage the listed roles, leaving roles granted by bots, boosts or
+# onboarding in place
+resource "discord_member_roles" "managed_only" {
+  guild_id           = "123456789012345678" # Replace with your guild ID
+  user_id            = "987654321098765432" # Replace with your user ID
+  managed_roles_only = true
+
+  roles = [
+    discord_role.member.id,
+  ]
+}
+
+# Remove every role from the member when this resource is destroyed instead of
+# restoring the roles they had before
+resource "discord_member_roles" "clear_on_destroy" {
+  guild_id   = "123456789012345678" # Replace with your guild ID
+  user_id    = "987654321098765432" # Replace with your user ID
+  on_destroy = "clear"
+
+  roles = [
+    discord_role.member.id,
+  ]
+}
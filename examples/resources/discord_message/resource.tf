@@ -10,6 +10,37 @@ resource "discord_message" "welcome" {
   content    = "Welcome to the server! Please read the rules."
 }
 
+# Post the rules and keep them pinned to the channel
+resource "discord_message" "rules" {
+  channel_id = local.channel_id
+  content    = "1. Be kind.\n2. No spam.\n3. Keep it on topic."
+  pinned     = true
+}
+
+# Publish a release note to every server following an announcement channel
+resource "discord_message" "release_notes" {
+  channel_id = "234567890123456789" # Replace with your announcement channel ID
+  content    = "Version 2.4 is out!"
+  publish    = true
+}
+
+# Ping a single role without notifying anyone else mentioned in the content
+resource "discord_message" "maintenance" {
+  channel_id = local.channel_id
+  content    = "<@&234567890123456789> Maintenance starts in one hour. Questions go to <@&345678901234567890>."
+
+  allowed_mentions {
+    roles = ["234567890123456789"]
+  }
+}
+
+# Post silently and without link previews
+resource "discord_message" "changelog" {
+  channel_id = local.channel_id
+  content    = "Full changelog: https://example.com/changelog"
+  flags      = ["suppress_embeds", "suppress_notifications"]
+}
+
 # Send a message with an embed
 resource "discord_message" "announcement" {
   channel_id = local.channel_id
@@ -21,5 +52,159 @@ resource "discord_message" "announcement" {
     url         = "https://example.com/updates"
     color       = 3447003 # Blue color
     footer_text = "Posted by the bot"
+    timestamp   = "2025-01-02T15:04:05Z"
+
+    author_name     = "Release Bot"
+    author_icon_url = "https://example.com/bot.png"
+
+    field {
+      name   = "Version"
+      value  = "2.0.0"
+      inline = true
+    }
+
+    field {
+      name   = "Downtime"
+      value  = "None"
+      inline = true
+    }
+  }
+}
+
+# Link buttons and a select menu below the message content
+resource "discord_message" "links" {
+  channel_id = local.channel_id
+  content    = "Useful links and notification settings"
+
+  component {
+    type = "action_row"
+
+    button {
+      style = "link"
+      label = "Website"
+      url   = "https://example.com"
+    }
+
+    button {
+      style = "link"
+      label = "Status"
+      url   = "https://status.example.com"
+    }
+  }
+
+  component {
+    type = "action_row"
+
+    select {
+      type        = "string"
+      custom_id   = "notifications"
+      placeholder = "Choose what to be notified about"
+      max_values  = 2
+
+      option {
+        label = "Announcements"
+        value = "announcements"
+      }
+
+      option {
+        label = "Events"
+        value = "events"
+      }
+    }
+  }
+}
+
+# A role picker built from Components V2 layout components
+resource "discord_message" "role_picker" {
+  channel_id    = local.channel_id
+  components_v2 = true
+
+  component {
+    type         = "container"
+    accent_color = 5793266
+
+    component {
+      type    = "text_display"
+      content = "## Pick your roles"
+    }
+
+    component {
+      type = "separator"
+    }
+
+    component {
+      type  = "section"
+      texts = ["**Events**", "Get pinged when an event starts."]
+
+      button {
+        style     = "secondary"
+        label     = "Toggle"
+        emoji     = "🎉"
+        custom_id = "role-events"
+      }
+    }
+
+    component {
+      type = "action_row"
+
+      select {
+        type        = "role"
+        custom_id   = "role-picker"
+        placeholder = "Or pick several roles"
+        min_values  = 0
+        max_values  = 5
+      }
+    }
+  }
+}
+
+# Upload files with the message. Editing a file uploads it again.
+resource "discord_message" "release_notes" {
+  channel_id = local.channel_id
+  content    = "Release notes for this week"
+
+  attachment {
+    path        = "${path.module}/files/release-notes.pdf"
+    description = "Release notes as a PDF"
+  }
+
+  attachment {
+    path     = "${path.module}/files/screenshot.png"
+    filename = "new-dashboard.png"
+    spoiler  = true
   }
 }
+
+# Reference an uploaded file from another message
+resource "discord_message" "screenshot_embed" {
+  channel_id = local.channel_id
+
+  embed {
+    title     = "New dashboard"
+    image_url = discord_message.release_notes.attachment[1].url
+  }
+}
+
+# Run a poll. Set expire = true to close it before its duration is up.
+resource "discord_message" "event_poll" {
+  channel_id = local.channel_id
+
+  poll {
+    question          = "Which day works best for the game night?"
+    duration          = 48
+    allow_multiselect = true
+
+    answer {
+      text  = "Saturday"
+      emoji = "🎉"
+    }
+
+    answer {
+      text = "Sunday"
+    }
+  }
+}
+
+output "poll_votes" {
+  value = { for a in discord_message.event_poll.poll.answer : a.text => a.vote_count }
+}
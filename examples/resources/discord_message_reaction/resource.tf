@@ -0,0 +1,23 @@
+# SPDX-License-Identifier: MPL-2.0
+
+resource "discord_message" "role_menu" {
+  channel_id = "123456789012345678" # Replace with your channel ID
+  content    = "React to pick your roles: 🎮 gaming, 🎨 art, <:party:234567890123456789> events"
+}
+
+# Seed the role menu with the bot's reactions
+resource "discord_message_reaction" "role_menu" {
+  for_each = toset(["🎮", "🎨", "party:234567890123456789"])
+
+  channel_id = discord_message.role_menu.channel_id
+  message_id = discord_message.role_menu.id
+  emoji      = each.value
+}
+
+# Start a vote from zero by clearing reactions added before the bot's
+resource "discord_message_reaction" "vote" {
+  channel_id    = "123456789012345678"
+  message_id    = "345678901234567890"
+  emoji         = "👍"
+  remove_others = true
+}
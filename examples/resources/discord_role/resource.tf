@@ -21,6 +21,25 @@ resource "discord_role" "moderator" {
   position = 5
 }
 
+# Gradient role colors (requires the ENHANCED_ROLE_COLORS guild feature)
+resource "discord_role" "booster" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+  name     = "Booster"
+
+  colors = {
+    primary_color   = data.discord_color.blue.int
+    secondary_color = 10181046
+  }
+}
+
+# Role icon from a local image file (requires the ROLE_ICONS guild feature).
+# The icon is only re-uploaded when the image content changes.
+resource "discord_role" "supporter" {
+  guild_id  = "123456789012345678" # Replace with your guild ID
+  name      = "Supporter"
+  icon_file = "${path.module}/icons/supporter.png"
+}
+
 # Compute moderator permissions
 data "discord_permission" "moderator" {
   manage_messages = true
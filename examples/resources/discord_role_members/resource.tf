@@ -0,0 +1,12 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Manage the roster of users holding the Moderator role
+resource "discord_role_members" "moderators" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+  role_id  = discord_role.moderator.id
+
+  user_ids = [
+    "111111111111111111", # Replace with user IDs
+    "222222222222222222",
+  ]
+}
@@ -0,0 +1,12 @@
+# SPDX-License-Identifier: MPL-2.0
+
+# Order the guild's roles from the top of the hierarchy to the bottom.
+resource "discord_role_order" "example" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+
+  role_ids = [
+    discord_role.admin.id,
+    discord_role.moderator.id,
+    discord_role.member.id,
+  ]
+}
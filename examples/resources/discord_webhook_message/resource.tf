@@ -0,0 +1,28 @@
+# SPDX-License-Identifier: MPL-2.0
+
+variable "announcements_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+# Post through a webhook with a custom name and avatar. No bot token is needed
+# when the provider only manages webhook messages.
+resource "discord_webhook_message" "release" {
+  webhook_url = var.announcements_webhook_url
+  username    = "Release Bot"
+  avatar_url  = "https://example.com/release-bot.png"
+  content     = "Version 2.4 is out!"
+
+  embed {
+    title       = "What's new"
+    description = "Faster builds and a new dark theme."
+    color       = 5763719
+  }
+}
+
+# Post into an existing thread of the webhook's channel
+resource "discord_webhook_message" "thread_update" {
+  webhook_url = var.announcements_webhook_url
+  thread_id   = "123456789012345678" # Replace with your thread ID
+  content     = "Deployment finished."
+}
@@ -12,15 +12,19 @@ type CreateBanParams struct {
 	DeleteMessageSeconds *int    `json:"delete_message_seconds,omitempty"`
 }
 
-// GetGuildBans returns a list of ban objects for the guild.
+// maxBansPageSize is the largest page Discord returns from the guild bans
+// endpoint.
+const maxBansPageSize = 1000
+
+// GetGuildBans returns every ban object for the guild, ordered by user ID.
 func (c *Client) GetGuildBans(ctx context.Context, guildID Snowflake) ([]*Ban, error) {
-	var bans []*Ban
 	route := fmt.Sprintf("/guilds/%s/bans", guildID)
-	err := c.doRequest(ctx, http.MethodGet, route, nil, &bans)
-	if err != nil {
-		return nil, err
-	}
-	return bans, nil
+	return paginateAfter(ctx, c, route, maxBansPageSize, func(b *Ban) Snowflake {
+		if b.User == nil {
+			return ""
+		}
+		return b.User.ID
+	})
 }
 
 // GetGuildBan returns the ban object for the given user.
@@ -58,6 +58,11 @@ type EditPermissionsParams struct {
 	Type  int     `json:"type"` // 0 = role, 1 = member
 }
 
+// FollowAnnouncementChannelParams are the parameters for following an announcement channel.
+type FollowAnnouncementChannelParams struct {
+	WebhookChannelID Snowflake `json:"webhook_channel_id"`
+}
+
 // CreateGuildChannel creates a new channel in a guild.
 func (c *Client) CreateGuildChannel(ctx context.Context, guildID Snowflake, params *CreateChannelParams) (*Channel, error) {
 	channel := new(Channel)
@@ -108,3 +113,15 @@ func (c *Client) DeleteChannelPermission(ctx context.Context, channelID Snowflak
 	route := fmt.Sprintf("/channels/%s/permissions/%s", channelID, overwriteID)
 	return c.doRequestNoContent(ctx, http.MethodDelete, route, nil)
 }
+
+// FollowAnnouncementChannel follows an announcement channel to send messages to a target channel.
+// Discord creates a channel follower webhook in the target channel.
+func (c *Client) FollowAnnouncementChannel(ctx context.Context, channelID Snowflake, params *FollowAnnouncementChannelParams) (*FollowedChannel, error) {
+	followed := new(FollowedChannel)
+	route := fmt.Sprintf("/channels/%s/followers", channelID)
+	err := c.doRequest(ctx, http.MethodPost, route, params, followed)
+	if err != nil {
+		return nil, err
+	}
+	return followed, nil
+}
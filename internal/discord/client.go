@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -22,6 +25,12 @@ const (
 
 	// baseBackoff is the base backoff duration for retries.
 	baseBackoff = 1 * time.Second
+
+	// maxRateLimitWait is the longest rate limit the client waits out before
+	// retrying. Longer limits, such as the hourly limit on publishing messages
+	// in an announcement channel, are returned as a RateLimitError instead of
+	// stalling the request.
+	maxRateLimitWait = 1 * time.Minute
 )
 
 // rateLimitBucket tracks rate limit state for a specific route.
@@ -56,8 +65,38 @@ func NewClient(token, version string) *Client {
 	}
 }
 
-// getBucket returns the rate limit bucket for a given route, creating one if it does not exist.
-func (c *Client) getBucket(route string) *rateLimitBucket {
+// HasToken reports whether the client was created with a bot token. Without
+// one only webhook routes, which carry their own token, can be called.
+func (c *Client) HasToken() bool {
+	return c.token != ""
+}
+
+// bucketKey returns the rate limit bucket key for a request. Discord shares a
+// bucket between requests with the same method that differ only in their query
+// string or in IDs after the major parameter (guild, channel or webhook), so
+// PUT /guilds/1/members/2/roles/3 and PUT /guilds/1/members/4/roles/5 are
+// throttled together, while DELETE on the same route has a bucket of its own.
+func bucketKey(method, route string) string {
+	route, _, _ = strings.Cut(route, "?")
+	segments := strings.Split(route, "/")
+	for i := 1; i < len(segments); i++ {
+		switch segments[i-1] {
+		case "guilds", "channels", "webhooks":
+			if i == 2 {
+				continue
+			}
+		}
+		if _, err := strconv.ParseUint(segments[i], 10, 64); err == nil {
+			segments[i] = ":id"
+		}
+	}
+	return method + " " + strings.Join(segments, "/")
+}
+
+// getBucket returns the rate limit bucket for a given method and route, creating one if it does not exist.
+func (c *Client) getBucket(method, route string) *rateLimitBucket {
+	route = bucketKey(method, route)
+
 	c.mu.RLock()
 	b, ok := c.buckets[route]
 	c.mu.RUnlock()
@@ -127,7 +166,7 @@ func (c *Client) doRequestNoContent(ctx context.Context, method, route string, b
 
 // doRequestInternal is the core HTTP request handler with retries and rate limiting.
 func (c *Client) doRequestInternal(ctx context.Context, method, route string, body interface{}, result interface{}, noContent bool) error {
-	bucket := c.getBucket(route)
+	bucket := c.getBucket(method, route)
 
 	var lastErr error
 	for attempt := 0; attempt <= maxRetries; attempt++ {
@@ -147,7 +186,15 @@ func (c *Client) doRequestInternal(ctx context.Context, method, route string, bo
 
 		// Build request body.
 		var reqBody io.Reader
-		if body != nil {
+		contentType := "application/json"
+		if mp, ok := body.(*multipartBody); ok {
+			data, ct, err := mp.encode()
+			if err != nil {
+				return fmt.Errorf("failed to encode multipart request body: %w", err)
+			}
+			reqBody = bytes.NewReader(data)
+			contentType = ct
+		} else if body != nil {
 			jsonData, err := json.Marshal(body)
 			if err != nil {
 				return fmt.Errorf("failed to marshal request body: %w", err)
@@ -156,21 +203,29 @@ func (c *Client) doRequestInternal(ctx context.Context, method, route string, bo
 		}
 
 		// Build the HTTP request.
-		url := c.baseURL + route
-		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+route, reqBody)
 		if err != nil {
 			return fmt.Errorf("failed to create request: %w", err)
 		}
 
-		req.Header.Set("Authorization", "Bot "+c.token)
+		// Webhook routes authenticate with the webhook token in the route,
+		// so a client without a bot token can still call them.
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bot "+c.token)
+		}
 		req.Header.Set("User-Agent", c.userAgent)
 		if body != nil {
-			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Content-Type", contentType)
 		}
 
 		// Execute the request.
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			// Leave out the URL, which contains the token of webhook routes.
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				err = urlErr.Err
+			}
 			lastErr = fmt.Errorf("HTTP request failed: %w", err)
 			continue
 		}
@@ -201,6 +256,9 @@ func (c *Client) doRequestInternal(ctx context.Context, method, route string, bo
 				Global:     rlErr.Global,
 				Message:    rlErr.Message,
 			}
+			if time.Duration(rlErr.RetryAfter*float64(time.Second)) > maxRateLimitWait {
+				return lastErr
+			}
 			continue
 		}
 
@@ -3,6 +3,7 @@ package discord
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -162,6 +163,34 @@ func TestDoRequest_RateLimit_RetriesAfterDelay(t *testing.T) {
 	}
 }
 
+// ---------- TestDoRequest_RateLimit_LongRetryAfter ----------
+
+func TestDoRequest_RateLimit_LongRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var attempt atomic.Int32
+
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempt.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"message": "You are being rate limited.", "retry_after": 1800, "global": false}`))
+	})
+	defer server.Close()
+
+	err := client.doRequest(context.Background(), http.MethodPost, "/channels/1/messages/2/crosspost", nil, nil)
+	var rlErr *RateLimitError
+	if !errors.As(err, &rlErr) {
+		t.Fatalf("expected RateLimitError, got %v", err)
+	}
+	if rlErr.RetryAfter != 1800 {
+		t.Errorf("expected retry_after 1800, got %v", rlErr.RetryAfter)
+	}
+	if got := attempt.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
 // ---------- TestDoRequest_ServerError_Retries ----------
 
 func TestDoRequest_ServerError_Retries(t *testing.T) {
@@ -413,7 +442,7 @@ func TestDoRequest_UpdatesRateLimitHeaders(t *testing.T) {
 	}
 
 	route := "/test/rate-headers"
-	bucket := client.getBucket(route)
+	bucket := client.getBucket(http.MethodGet, route)
 	bucket.mu.Lock()
 	remaining := bucket.remaining
 	resetAt := bucket.resetAt
@@ -483,9 +512,9 @@ func TestGetBucket_CreatesAndReuses(t *testing.T) {
 
 	client := NewClient("tok", "v")
 
-	b1 := client.getBucket("/channels/1")
-	b2 := client.getBucket("/channels/1")
-	b3 := client.getBucket("/channels/2")
+	b1 := client.getBucket(http.MethodGet, "/channels/1")
+	b2 := client.getBucket(http.MethodGet, "/channels/1")
+	b3 := client.getBucket(http.MethodGet, "/channels/2")
 
 	if b1 != b2 {
 		t.Error("expected same bucket for same route")
@@ -498,6 +527,61 @@ func TestGetBucket_CreatesAndReuses(t *testing.T) {
 	}
 }
 
+// ---------- TestBucketKey ----------
+
+func TestBucketKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		method   string
+		route    string
+		expected string
+	}{
+		{name: "major only", method: http.MethodGet, route: "/guilds/123", expected: "GET /guilds/123"},
+		{name: "minor id", method: http.MethodGet, route: "/guilds/123/members/456", expected: "GET /guilds/123/members/:id"},
+		{name: "member role", method: http.MethodPut, route: "/guilds/123/members/456/roles/789", expected: "PUT /guilds/123/members/:id/roles/:id"},
+		{name: "query", method: http.MethodGet, route: "/guilds/123/members?limit=1000&after=456", expected: "GET /guilds/123/members"},
+		{name: "channel message", method: http.MethodGet, route: "/channels/123/messages/456", expected: "GET /channels/123/messages/:id"},
+		{name: "delete message", method: http.MethodDelete, route: "/channels/123/messages/456", expected: "DELETE /channels/123/messages/:id"},
+		{name: "webhook token", method: http.MethodPost, route: "/webhooks/123/abc", expected: "POST /webhooks/123/abc"},
+		{name: "no ids", method: http.MethodGet, route: "/users/@me", expected: "GET /users/@me"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := bucketKey(tc.method, tc.route)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestGetBucket_SharesMinorRoutes ----------
+
+func TestGetBucket_SharesMinorRoutes(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient("tok", "v")
+
+	b1 := client.getBucket(http.MethodPut, "/guilds/1/members/2/roles/3")
+	b2 := client.getBucket(http.MethodPut, "/guilds/1/members/4/roles/5")
+	b3 := client.getBucket(http.MethodPut, "/guilds/2/members/2/roles/3")
+	b4 := client.getBucket(http.MethodDelete, "/guilds/1/members/2/roles/3")
+
+	if b1 != b2 {
+		t.Error("expected same bucket for routes differing only in minor IDs")
+	}
+	if b1 == b3 {
+		t.Error("expected different bucket for a different guild")
+	}
+	if b1 == b4 {
+		t.Error("expected different bucket for a different method")
+	}
+}
+
 // ---------- TestDoRequest_404_NonJSONBody ----------
 
 func TestDoRequest_404_NonJSONBody(t *testing.T) {
@@ -563,7 +647,7 @@ func TestWaitForRateLimit_ContextCancelled(t *testing.T) {
 	t.Parallel()
 
 	client := NewClient("tok", "v")
-	bucket := client.getBucket("/test/wait-cancel")
+	bucket := client.getBucket(http.MethodGet, "/test/wait-cancel")
 
 	// Set the bucket to rate-limited state with a long reset time.
 	bucket.mu.Lock()
@@ -589,7 +673,7 @@ func TestWaitForRateLimit_NotLimited(t *testing.T) {
 	t.Parallel()
 
 	client := NewClient("tok", "v")
-	bucket := client.getBucket("/test/wait-ok")
+	bucket := client.getBucket(http.MethodGet, "/test/wait-ok")
 
 	// Bucket has remaining > 0, so should not wait.
 	bucket.mu.Lock()
@@ -6,6 +6,12 @@ import (
 	"fmt"
 )
 
+// JSON error codes returned by the Discord API.
+const (
+	ErrCodeMaxPinsReached   = 30003
+	ErrCodeTargetNotInVoice = 40032
+)
+
 // DiscordAPIError represents an error response from the Discord API.
 type DiscordAPIError struct {
 	HTTPStatus int             `json:"-"`
@@ -50,3 +56,18 @@ func IsNotFound(err error) bool {
 
 	return false
 }
+
+// HasErrorCode returns true if the error is a DiscordAPIError with the given
+// JSON error code.
+func HasErrorCode(err error, code int) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *DiscordAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == code
+	}
+
+	return false
+}
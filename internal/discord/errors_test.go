@@ -66,6 +66,40 @@ func TestIsNotFound_WithWrappedError(t *testing.T) {
 	}
 }
 
+// ---------- TestHasErrorCode ----------
+
+func TestHasErrorCode(t *testing.T) {
+	t.Parallel()
+
+	pinsErr := &DiscordAPIError{
+		HTTPStatus: 400,
+		Code:       ErrCodeMaxPinsReached,
+		Message:    "Maximum number of pins reached for the channel (250)",
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		code     int
+		expected bool
+	}{
+		{name: "matching code", err: pinsErr, code: ErrCodeMaxPinsReached, expected: true},
+		{name: "different code", err: pinsErr, code: 50013, expected: false},
+		{name: "wrapped", err: fmt.Errorf("wrapped: %w", pinsErr), code: ErrCodeMaxPinsReached, expected: true},
+		{name: "other error", err: errors.New("some random error"), code: ErrCodeMaxPinsReached, expected: false},
+		{name: "nil", err: nil, code: ErrCodeMaxPinsReached, expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := HasErrorCode(tc.err, tc.code); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
 // ---------- TestDiscordAPIError_Error ----------
 
 func TestDiscordAPIError_Error(t *testing.T) {
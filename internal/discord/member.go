@@ -4,18 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
+	"net/url"
 )
 
-// ModifyMemberParams are the parameters for modifying a guild member.
+// ModifyMemberParams are the parameters for modifying a guild member. Roles is
+// a pointer so that an empty list can be sent to remove every role.
 type ModifyMemberParams struct {
-	Nick                       *string    `json:"nick,omitempty"`
-	Roles                      []Snowflake `json:"roles,omitempty"`
-	Mute                       *bool      `json:"mute,omitempty"`
-	Deaf                       *bool      `json:"deaf,omitempty"`
-	ChannelID                  *Snowflake `json:"channel_id,omitempty"`
-	CommunicationDisabledUntil *time.Time `json:"communication_disabled_until,omitempty"`
-	Flags                      *int       `json:"flags,omitempty"`
+	Nick                       *NullString  `json:"nick,omitempty"`
+	Roles                      *[]Snowflake `json:"roles,omitempty"`
+	Mute                       *bool        `json:"mute,omitempty"`
+	Deaf                       *bool        `json:"deaf,omitempty"`
+	ChannelID                  *Snowflake   `json:"channel_id,omitempty"`
+	CommunicationDisabledUntil *NullTime    `json:"communication_disabled_until,omitempty"`
+	Flags                      *int         `json:"flags,omitempty"`
 }
 
 // GetGuildMember returns a guild member for the given user ID.
@@ -29,6 +30,40 @@ func (c *Client) GetGuildMember(ctx context.Context, guildID Snowflake, userID S
 	return member, nil
 }
 
+// maxMembersPageSize is the largest page Discord returns from the list guild
+// members endpoint.
+const maxMembersPageSize = 1000
+
+// MaxMemberSearchLimit is the most members a guild member search can return.
+const MaxMemberSearchLimit = 1000
+
+// ListGuildMembers returns every member of a guild, ordered by user ID. The
+// bot needs the privileged GUILD_MEMBERS intent.
+func (c *Client) ListGuildMembers(ctx context.Context, guildID Snowflake) ([]*Member, error) {
+	route := fmt.Sprintf("/guilds/%s/members", guildID)
+	return paginateAfter(ctx, c, route, maxMembersPageSize, memberUserID)
+}
+
+// SearchGuildMembers returns up to limit members whose username or nickname
+// starts with the query. Discord caps limit at 1000 and does not page results.
+func (c *Client) SearchGuildMembers(ctx context.Context, guildID Snowflake, query string, limit int) ([]*Member, error) {
+	var members []*Member
+	route := fmt.Sprintf("/guilds/%s/members/search?query=%s&limit=%d", guildID, url.QueryEscape(query), limit)
+	err := c.doRequest(ctx, http.MethodGet, route, nil, &members)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// memberUserID returns the user ID of a member, used as the pagination cursor.
+func memberUserID(m *Member) Snowflake {
+	if m.User == nil {
+		return ""
+	}
+	return m.User.ID
+}
+
 // ModifyGuildMember modifies attributes of a guild member.
 func (c *Client) ModifyGuildMember(ctx context.Context, guildID Snowflake, userID Snowflake, params *ModifyMemberParams) (*Member, error) {
 	member := new(Member)
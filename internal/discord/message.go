@@ -4,28 +4,66 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
 )
 
+// maxReactionsPageSize is the most users Discord returns per page of a
+// message's reactions.
+const maxReactionsPageSize = 100
+
+// MaxMessagesPageSize is the most messages Discord returns per request for
+// the messages of a channel.
+const MaxMessagesPageSize = 100
+
+// defaultMessagesLimit is the number of messages Discord returns when no
+// limit is given.
+const defaultMessagesLimit = 50
+
 // CreateMessageParams are the parameters for creating a message.
 type CreateMessageParams struct {
-	Content *string  `json:"content,omitempty"`
-	TTS     *bool    `json:"tts,omitempty"`
-	Embeds  []*Embed `json:"embeds,omitempty"`
-	Flags   *int     `json:"flags,omitempty"`
+	Content         *string             `json:"content,omitempty"`
+	TTS             *bool               `json:"tts,omitempty"`
+	Embeds          []*Embed            `json:"embeds,omitempty"`
+	Components      []*Component        `json:"components,omitempty"`
+	Attachments     []*AttachmentParams `json:"attachments,omitempty"`
+	AllowedMentions *AllowedMentions    `json:"allowed_mentions,omitempty"`
+	Flags           *int                `json:"flags,omitempty"`
+	Poll            *PollCreateRequest  `json:"poll,omitempty"`
+
+	// Files are uploaded with the message; when set the request is sent as
+	// multipart/form-data.
+	Files []*File `json:"-"`
 }
 
 // EditMessageParams are the parameters for editing a message.
+// Embeds, Components and Attachments are pointers so that an empty list can be
+// sent to remove every embed, component or attachment from the message.
+// Existing attachments missing from Attachments are removed.
 type EditMessageParams struct {
-	Content *string  `json:"content,omitempty"`
-	Embeds  []*Embed `json:"embeds,omitempty"`
-	Flags   *int     `json:"flags,omitempty"`
+	Content         *string              `json:"content,omitempty"`
+	Embeds          *[]*Embed            `json:"embeds,omitempty"`
+	Components      *[]*Component        `json:"components,omitempty"`
+	Attachments     *[]*AttachmentParams `json:"attachments,omitempty"`
+	AllowedMentions *AllowedMentions     `json:"allowed_mentions,omitempty"`
+	Flags           *int                 `json:"flags,omitempty"`
+
+	// Files are uploaded with the edit; when set the request is sent as
+	// multipart/form-data.
+	Files []*File `json:"-"`
 }
 
 // CreateMessage posts a message to a channel.
 func (c *Client) CreateMessage(ctx context.Context, channelID Snowflake, params *CreateMessageParams) (*Message, error) {
 	msg := new(Message)
 	route := fmt.Sprintf("/channels/%s/messages", channelID)
-	err := c.doRequest(ctx, http.MethodPost, route, params, msg)
+	var body interface{} = params
+	if len(params.Files) > 0 {
+		body = &multipartBody{payload: params, files: params.Files}
+	}
+	err := c.doRequest(ctx, http.MethodPost, route, body, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -43,11 +81,86 @@ func (c *Client) GetChannelMessage(ctx context.Context, channelID Snowflake, mes
 	return msg, nil
 }
 
+// GetChannelMessagesParams select the messages of a channel to return. At
+// most one of Before, After and Around may be set. Limit is the total number
+// of messages and defaults to 50; more than MaxMessagesPageSize are requested
+// in pages, except around a message, which Discord does not page.
+type GetChannelMessagesParams struct {
+	Before Snowflake
+	After  Snowflake
+	Around Snowflake
+	Limit  int
+}
+
+// GetChannelMessages returns messages of a channel, newest first.
+func (c *Client) GetChannelMessages(ctx context.Context, channelID Snowflake, params *GetChannelMessagesParams) ([]*Message, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultMessagesLimit
+	}
+	if params.Around != "" && limit > MaxMessagesPageSize {
+		limit = MaxMessagesPageSize
+	}
+
+	before, after := params.Before, params.After
+	var messages []*Message
+	for len(messages) < limit {
+		pageSize := min(limit-len(messages), MaxMessagesPageSize)
+		query := url.Values{"limit": {strconv.Itoa(pageSize)}}
+		switch {
+		case params.Around != "":
+			query.Set("around", params.Around.String())
+		case after != "":
+			query.Set("after", after.String())
+		case before != "":
+			query.Set("before", before.String())
+		}
+
+		var page []*Message
+		route := fmt.Sprintf("/channels/%s/messages?%s", channelID, query.Encode())
+		if err := c.doRequest(ctx, http.MethodGet, route, nil, &page); err != nil {
+			return nil, err
+		}
+		messages = append(messages, page...)
+		if params.Around != "" || len(page) < pageSize {
+			break
+		}
+
+		// Page away from the cursor: forward from the newest message when
+		// reading after it, backward from the oldest otherwise.
+		sortMessagesNewestFirst(page)
+		if after != "" {
+			after = page[0].ID
+		} else {
+			before = page[len(page)-1].ID
+		}
+	}
+
+	sortMessagesNewestFirst(messages)
+	return messages, nil
+}
+
+// sortMessagesNewestFirst sorts messages by descending ID, which orders them
+// by creation time.
+func sortMessagesNewestFirst(messages []*Message) {
+	sort.SliceStable(messages, func(i, j int) bool {
+		a, b := messages[i].ID, messages[j].ID
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a > b
+	})
+}
+
 // EditMessage edits a previously sent message.
 func (c *Client) EditMessage(ctx context.Context, channelID Snowflake, messageID Snowflake, params *EditMessageParams) (*Message, error) {
 	msg := new(Message)
 	route := fmt.Sprintf("/channels/%s/messages/%s", channelID, messageID)
-	err := c.doRequest(ctx, http.MethodPatch, route, params, msg)
+	var body interface{} = params
+	if len(params.Files) > 0 {
+		body = &multipartBody{payload: params, files: params.Files}
+	}
+	err := c.doRequest(ctx, http.MethodPatch, route, body, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -60,3 +173,98 @@ func (c *Client) DeleteMessage(ctx context.Context, channelID Snowflake, message
 	return c.doRequestNoContent(ctx, http.MethodDelete, route, nil)
 }
 
+// maxPinsPageSize is the largest page Discord returns from the channel pins endpoint.
+const maxPinsPageSize = 50
+
+// CrosspostMessage publishes a message in an announcement channel to the
+// channels following it. Discord allows only a few publishes per channel each
+// hour; see maxRateLimitWait for how longer limits are reported.
+func (c *Client) CrosspostMessage(ctx context.Context, channelID Snowflake, messageID Snowflake) (*Message, error) {
+	msg := new(Message)
+	route := fmt.Sprintf("/channels/%s/messages/%s/crosspost", channelID, messageID)
+	err := c.doRequest(ctx, http.MethodPost, route, nil, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// GetChannelPins returns every pinned message in a channel, newest pin first.
+// Pages are requested until Discord reports there are no more pins.
+func (c *Client) GetChannelPins(ctx context.Context, channelID Snowflake) ([]*MessagePin, error) {
+	var pins []*MessagePin
+	var before *time.Time
+	for {
+		route := fmt.Sprintf("/channels/%s/messages/pins?limit=%d", channelID, maxPinsPageSize)
+		if before != nil {
+			route += "&before=" + url.QueryEscape(before.Format(time.RFC3339Nano))
+		}
+
+		page := new(ChannelPins)
+		err := c.doRequest(ctx, http.MethodGet, route, nil, page)
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, page.Items...)
+
+		if !page.HasMore || len(page.Items) == 0 {
+			return pins, nil
+		}
+		last := page.Items[len(page.Items)-1].PinnedAt
+		before = &last
+	}
+}
+
+// PinMessage pins a message in a channel.
+func (c *Client) PinMessage(ctx context.Context, channelID Snowflake, messageID Snowflake) error {
+	route := fmt.Sprintf("/channels/%s/messages/pins/%s", channelID, messageID)
+	return c.doRequestNoContent(ctx, http.MethodPut, route, nil)
+}
+
+// UnpinMessage unpins a message in a channel.
+func (c *Client) UnpinMessage(ctx context.Context, channelID Snowflake, messageID Snowflake) error {
+	route := fmt.Sprintf("/channels/%s/messages/pins/%s", channelID, messageID)
+	return c.doRequestNoContent(ctx, http.MethodDelete, route, nil)
+}
+
+// reactionRoute returns the route of the reactions with an emoji on a
+// message. The emoji is a unicode emoji or name:id for a custom emoji.
+func reactionRoute(channelID, messageID Snowflake, emoji string) string {
+	return fmt.Sprintf("/channels/%s/messages/%s/reactions/%s", channelID, messageID, url.PathEscape(emoji))
+}
+
+// CreateReaction adds the bot's reaction with an emoji to a message.
+func (c *Client) CreateReaction(ctx context.Context, channelID, messageID Snowflake, emoji string) error {
+	return c.doRequestNoContent(ctx, http.MethodPut, reactionRoute(channelID, messageID, emoji)+"/@me", nil)
+}
+
+// DeleteOwnReaction removes the bot's reaction with an emoji from a message.
+func (c *Client) DeleteOwnReaction(ctx context.Context, channelID, messageID Snowflake, emoji string) error {
+	return c.doRequestNoContent(ctx, http.MethodDelete, reactionRoute(channelID, messageID, emoji)+"/@me", nil)
+}
+
+// DeleteUserReaction removes another user's reaction with an emoji from a
+// message. Requires the MANAGE_MESSAGES permission.
+func (c *Client) DeleteUserReaction(ctx context.Context, channelID, messageID Snowflake, emoji string, userID Snowflake) error {
+	route := fmt.Sprintf("%s/%s", reactionRoute(channelID, messageID, emoji), userID)
+	return c.doRequestNoContent(ctx, http.MethodDelete, route, nil)
+}
+
+// GetReactions returns every user that reacted to a message with an emoji.
+func (c *Client) GetReactions(ctx context.Context, channelID, messageID Snowflake, emoji string) ([]*User, error) {
+	return paginateAfter(ctx, c, reactionRoute(channelID, messageID, emoji), maxReactionsPageSize, func(u *User) Snowflake {
+		return u.ID
+	})
+}
+
+// EndPoll ends the poll of a message immediately. Only the author of the
+// message can end its poll.
+func (c *Client) EndPoll(ctx context.Context, channelID, messageID Snowflake) (*Message, error) {
+	msg := new(Message)
+	route := fmt.Sprintf("/channels/%s/polls/%s/expire", channelID, messageID)
+	err := c.doRequest(ctx, http.MethodPost, route, nil, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
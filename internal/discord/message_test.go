@@ -0,0 +1,270 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// ---------- TestCrosspostMessage ----------
+
+func TestCrosspostMessage(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/channels/10/messages/1/crosspost" {
+			t.Errorf("expected path /channels/10/messages/1/crosspost, got %q", r.URL.Path)
+		}
+		flags := MessageFlagCrossposted
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Message{ID: "1", Flags: &flags})
+	})
+	defer server.Close()
+
+	msg, err := client.CrosspostMessage(context.Background(), "10", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Flags == nil || *msg.Flags&MessageFlagCrossposted == 0 {
+		t.Errorf("expected crossposted flag, got %v", msg.Flags)
+	}
+}
+
+// ---------- TestEditMessage_EmptyEmbeds ----------
+
+func TestEditMessage_EmptyEmbeds(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding body: %v", err)
+		}
+		if got := string(body["embeds"]); got != "[]" {
+			t.Errorf("expected embeds to be sent as an empty list, got %q", got)
+		}
+		if _, ok := body["content"]; ok {
+			t.Error("expected content to be left out")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Message{ID: "1"})
+	})
+	defer server.Close()
+
+	embeds := []*Embed{}
+	_, err := client.EditMessage(context.Background(), "10", "1", &EditMessageParams{Embeds: &embeds})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// ---------- TestReactionRoutes ----------
+
+func TestReactionRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		call  func(*Client) error
+		route string
+	}{
+		{
+			name:  "create unicode",
+			call:  func(c *Client) error { return c.CreateReaction(context.Background(), "10", "1", "👍") },
+			route: "PUT /channels/10/messages/1/reactions/%F0%9F%91%8D/@me",
+		},
+		{
+			name:  "delete own custom",
+			call:  func(c *Client) error { return c.DeleteOwnReaction(context.Background(), "10", "1", "party:123") },
+			route: "DELETE /channels/10/messages/1/reactions/party:123/@me",
+		},
+		{
+			name:  "delete user",
+			call:  func(c *Client) error { return c.DeleteUserReaction(context.Background(), "10", "1", "party:123", "7") },
+			route: "DELETE /channels/10/messages/1/reactions/party:123/7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Method + " " + r.URL.EscapedPath(); got != tc.route {
+					t.Errorf("expected %q, got %q", tc.route, got)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+			defer server.Close()
+
+			if err := tc.call(client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// ---------- TestGetReactions_Paginates ----------
+
+func TestGetReactions_Paginates(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		after, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		var users []User
+		for i := after + 1; i <= 150 && len(users) < maxReactionsPageSize; i++ {
+			users = append(users, User{ID: Snowflake(fmt.Sprint(i))})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(users)
+	})
+	defer server.Close()
+
+	users, err := client.GetReactions(context.Background(), "10", "1", "👍")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 150 {
+		t.Errorf("expected 150 users, got %d", len(users))
+	}
+}
+
+// ---------- TestEndPoll ----------
+
+func TestEndPoll(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/channels/10/polls/1/expire" {
+			t.Errorf("expected path /channels/10/polls/1/expire, got %q", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","poll":{"question":{"text":"Q?"},"answers":[{"answer_id":1,"poll_media":{"text":"A"}}],` +
+			`"results":{"is_finalized":true,"answer_counts":[{"id":1,"count":3,"me_voted":false}]}}}`))
+	})
+	defer server.Close()
+
+	msg, err := client.EndPoll(context.Background(), "10", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Poll == nil || msg.Poll.Results == nil || !msg.Poll.Results.IsFinalized {
+		t.Fatalf("expected finalized poll results, got %+v", msg.Poll)
+	}
+	if counts := msg.Poll.Results.AnswerCounts; len(counts) != 1 || counts[0].ID != 1 || counts[0].Count != 3 {
+		t.Errorf("expected one answer count of 3 for answer 1, got %+v", counts)
+	}
+}
+
+// ---------- TestGetChannelMessages_Paginates ----------
+
+func TestGetChannelMessages_Paginates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		params          GetChannelMessagesParams
+		expectedQueries []string
+		expectedNewest  Snowflake
+		expectedOldest  Snowflake
+		expectedCount   int
+	}{
+		{
+			name:            "latest",
+			params:          GetChannelMessagesParams{Limit: 150},
+			expectedQueries: []string{"limit=100", "before=151&limit=50"},
+			expectedNewest:  "250",
+			expectedOldest:  "101",
+			expectedCount:   150,
+		},
+		{
+			name:            "after",
+			params:          GetChannelMessagesParams{After: "5", Limit: 150},
+			expectedQueries: []string{"after=5&limit=100", "after=105&limit=50"},
+			expectedNewest:  "155",
+			expectedOldest:  "6",
+			expectedCount:   150,
+		},
+		{
+			name:            "before stops at short page",
+			params:          GetChannelMessagesParams{Before: "20", Limit: 150},
+			expectedQueries: []string{"before=20&limit=100"},
+			expectedNewest:  "19",
+			expectedOldest:  "1",
+			expectedCount:   19,
+		},
+		{
+			name:            "around is not paged",
+			params:          GetChannelMessagesParams{Around: "100", Limit: 500},
+			expectedQueries: []string{"around=100&limit=100"},
+			expectedNewest:  "149",
+			expectedOldest:  "50",
+			expectedCount:   100,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// The channel holds messages 1 to 250. Pages are returned newest
+			// first, like Discord does.
+			var queries []string
+			client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				queries = append(queries, r.URL.RawQuery)
+				limit, _ := strconv.Atoi(q.Get("limit"))
+				low, high := 1, 250
+				if v := q.Get("before"); v != "" {
+					high, _ = strconv.Atoi(v)
+					high--
+					low = max(low, high-limit+1)
+				} else if v := q.Get("after"); v != "" {
+					low, _ = strconv.Atoi(v)
+					low++
+					high = min(high, low+limit-1)
+				} else if v := q.Get("around"); v != "" {
+					around, _ := strconv.Atoi(v)
+					low, high = around-limit/2, around+limit/2-1
+				} else {
+					low = high - limit + 1
+				}
+
+				page := []Message{}
+				for id := high; id >= low; id-- {
+					page = append(page, Message{ID: Snowflake(strconv.Itoa(id))})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(page)
+			})
+			defer server.Close()
+
+			messages, err := client.GetChannelMessages(context.Background(), "10", &tc.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(queries) != fmt.Sprint(tc.expectedQueries) {
+				t.Errorf("expected queries %v, got %v", tc.expectedQueries, queries)
+			}
+			if len(messages) != tc.expectedCount {
+				t.Fatalf("expected %d messages, got %d", tc.expectedCount, len(messages))
+			}
+			if messages[0].ID != tc.expectedNewest || messages[len(messages)-1].ID != tc.expectedOldest {
+				t.Errorf("expected messages %s to %s, got %s to %s",
+					tc.expectedNewest, tc.expectedOldest, messages[0].ID, messages[len(messages)-1].ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package discord
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// quoteEscaper escapes quotes and backslashes in a multipart header value.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// File is a file uploaded with a request. The content is held in memory so
+// the request body can be rebuilt when the request is retried.
+type File struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// AttachmentParams describes an attachment in a message create or edit
+// request. New uploads use the index of their file in the request as ID;
+// existing attachments to keep use their snowflake ID.
+type AttachmentParams struct {
+	ID          Snowflake `json:"id"`
+	Filename    *string   `json:"filename,omitempty"`
+	Description *string   `json:"description,omitempty"`
+}
+
+// multipartBody is a request body sent as multipart/form-data: the JSON
+// payload in the payload_json field followed by the files as files[n].
+type multipartBody struct {
+	payload interface{}
+	files   []*File
+}
+
+// encode returns the encoded body and its Content-Type header.
+func (b *multipartBody) encode() ([]byte, string, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	payload, err := json.Marshal(b.payload)
+	if err != nil {
+		return nil, "", err
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="payload_json"`)
+	header.Set("Content-Type", "application/json")
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := part.Write(payload); err != nil {
+		return nil, "", err
+	}
+
+	for i, f := range b.files {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition",
+			fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, quoteEscaper.Replace(f.Name)))
+		contentType := f.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header.Set("Content-Type", contentType)
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := part.Write(f.Data); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), w.FormDataContentType(), nil
+}
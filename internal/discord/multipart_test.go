@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+// ---------- TestMultipartBody_Encode ----------
+
+func TestMultipartBody_Encode(t *testing.T) {
+	t.Parallel()
+
+	name := `a "quoted" name.png`
+	body := &multipartBody{
+		payload: &CreateMessageParams{
+			Attachments: []*AttachmentParams{{ID: "0", Filename: &name}},
+		},
+		files: []*File{
+			{Name: name, ContentType: "image/png", Data: []byte("png")},
+			{Name: "notes.txt", Data: []byte("text")},
+		},
+	}
+
+	data, contentType, err := body.encode()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("expected multipart/form-data, got %q (%v)", contentType, err)
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(data), params["boundary"])
+	expected := []struct {
+		field       string
+		filename    string
+		contentType string
+		content     string
+	}{
+		{field: "payload_json", contentType: "application/json", content: `{"attachments":[{"id":"0","filename":"a \"quoted\" name.png"}]}`},
+		{field: "files[0]", filename: name, contentType: "image/png", content: "png"},
+		{field: "files[1]", filename: "notes.txt", contentType: "application/octet-stream", content: "text"},
+	}
+	for _, want := range expected {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("expected part %q, got error: %v", want.field, err)
+		}
+		if part.FormName() != want.field {
+			t.Errorf("expected field %q, got %q", want.field, part.FormName())
+		}
+		if part.FileName() != want.filename {
+			t.Errorf("expected filename %q, got %q", want.filename, part.FileName())
+		}
+		if got := part.Header.Get("Content-Type"); got != want.contentType {
+			t.Errorf("expected Content-Type %q, got %q", want.contentType, got)
+		}
+		content, _ := io.ReadAll(part)
+		if string(content) != want.content {
+			t.Errorf("expected content %q, got %q", want.content, string(content))
+		}
+	}
+	if _, err := reader.NextPart(); err != io.EOF {
+		t.Errorf("expected no more parts, got %v", err)
+	}
+}
+
+// ---------- TestCreateMessage_Multipart ----------
+
+func TestCreateMessage_Multipart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		files     []*File
+		multipart bool
+	}{
+		{name: "json without files", multipart: false},
+		{name: "multipart with files", files: []*File{{Name: "a.txt", Data: []byte("a")}}, multipart: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+				if tc.multipart {
+					if mediaType != "multipart/form-data" {
+						t.Errorf("expected multipart/form-data, got %q", mediaType)
+					}
+					if r.FormValue("payload_json") == "" {
+						t.Error("expected payload_json field")
+					}
+				} else if mediaType != "application/json" {
+					t.Errorf("expected application/json, got %q", mediaType)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(Message{ID: "1"})
+			})
+			defer server.Close()
+
+			content := "hello"
+			msg, err := client.CreateMessage(context.Background(), "10", &CreateMessageParams{Content: &content, Files: tc.files})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if msg.ID != "1" {
+				t.Errorf("expected message ID %q, got %q", "1", msg.ID)
+			}
+		})
+	}
+}
+
+// ---------- TestDoRequest_MultipartRetry ----------
+
+func TestDoRequest_MultipartRetry(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("failed to parse multipart form: %v", err)
+		}
+		file, _, err := r.FormFile("files[0]")
+		if err != nil {
+			t.Errorf("expected files[0], got error: %v", err)
+		} else {
+			data, _ := io.ReadAll(file)
+			if string(data) != "payload" {
+				t.Errorf("expected file content %q on attempt %d, got %q", "payload", atomic.LoadInt32(&attempts)+1, string(data))
+			}
+		}
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	body := &multipartBody{payload: map[string]string{}, files: []*File{{Name: "a.bin", Data: []byte("payload")}}}
+	if err := client.doRequestNoContent(context.Background(), http.MethodPost, "/upload", body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
@@ -0,0 +1,38 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// paginateAfter requests every page of a list endpoint that pages forward
+// with the limit and after query parameters, such as guild members and bans.
+// Each page starts after the ID of the last item of the previous page, as
+// returned by id, and paging stops at the first page shorter than limit.
+func paginateAfter[T any](ctx context.Context, c *Client, route string, limit int, id func(T) Snowflake) ([]T, error) {
+	sep := "?"
+	if strings.Contains(route, "?") {
+		sep = "&"
+	}
+
+	var items []T
+	after := Snowflake("0")
+	for {
+		var page []T
+		err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("%s%slimit=%d&after=%s", route, sep, limit, after), nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+
+		if len(page) < limit {
+			return items, nil
+		}
+		after = id(page[len(page)-1])
+		if after == "" {
+			return items, nil
+		}
+	}
+}
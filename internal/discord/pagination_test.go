@@ -0,0 +1,108 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+type pageItem struct {
+	ID Snowflake `json:"id"`
+}
+
+func pageItemID(i *pageItem) Snowflake { return i.ID }
+
+// newPagingServer returns a test client serving items 1..total in pages,
+// honoring the limit and after query parameters. Requested after values are
+// recorded in afters.
+func newPagingServer(t *testing.T, total int, afters *[]string) *Client {
+	t.Helper()
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		*afters = append(*afters, q.Get("after"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		after, _ := strconv.Atoi(q.Get("after"))
+
+		page := []*pageItem{}
+		for id := after + 1; id <= total && len(page) < limit; id++ {
+			page = append(page, &pageItem{ID: Snowflake(strconv.Itoa(id))})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+	t.Cleanup(server.Close)
+	return client
+}
+
+// ---------- TestPaginateAfter ----------
+
+func TestPaginateAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		total          int
+		limit          int
+		expectedAfters []string
+	}{
+		{name: "empty", total: 0, limit: 2, expectedAfters: []string{"0"}},
+		{name: "single short page", total: 1, limit: 2, expectedAfters: []string{"0"}},
+		{name: "exact multiple", total: 4, limit: 2, expectedAfters: []string{"0", "2", "4"}},
+		{name: "partial last page", total: 5, limit: 2, expectedAfters: []string{"0", "2", "4"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var afters []string
+			client := newPagingServer(t, tc.total, &afters)
+
+			items, err := paginateAfter(context.Background(), client, "/guilds/1/members", tc.limit, pageItemID)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(items) != tc.total {
+				t.Fatalf("expected %d items, got %d", tc.total, len(items))
+			}
+			for i, item := range items {
+				if item.ID != Snowflake(strconv.Itoa(i+1)) {
+					t.Errorf("item %d: expected ID %d, got %s", i, i+1, item.ID)
+				}
+			}
+			if len(afters) != len(tc.expectedAfters) {
+				t.Fatalf("expected requests after %v, got %v", tc.expectedAfters, afters)
+			}
+			for i := range afters {
+				if afters[i] != tc.expectedAfters[i] {
+					t.Errorf("expected requests after %v, got %v", tc.expectedAfters, afters)
+					break
+				}
+			}
+		})
+	}
+}
+
+// ---------- TestPaginateAfter_ExistingQuery ----------
+
+func TestPaginateAfter_ExistingQuery(t *testing.T) {
+	t.Parallel()
+
+	var rawQuery string
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+	defer server.Close()
+
+	_, err := paginateAfter(context.Background(), client, "/guilds/1/bans?with_counts=true", 10, pageItemID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rawQuery != "with_counts=true&limit=10&after=0" {
+		t.Errorf("unexpected query %q", rawQuery)
+	}
+}
@@ -0,0 +1,148 @@
+package discord
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PermissionFlag maps a permission name to its bit value.
+type PermissionFlag struct {
+	Name string
+	Bit  uint64
+}
+
+// Permissions lists all Discord permission flags in bit order.
+var Permissions = []PermissionFlag{
+	{"create_instant_invite", 0x0000000000000001},
+	{"kick_members", 0x0000000000000002},
+	{"ban_members", 0x0000000000000004},
+	{"administrator", 0x0000000000000008},
+	{"manage_channels", 0x0000000000000010},
+	{"manage_guild", 0x0000000000000020},
+	{"add_reactions", 0x0000000000000040},
+	{"view_audit_log", 0x0000000000000080},
+	{"priority_speaker", 0x0000000000000100},
+	{"stream", 0x0000000000000200},
+	{"view_channel", 0x0000000000000400},
+	{"send_messages", 0x0000000000000800},
+	{"send_tts_messages", 0x0000000000001000},
+	{"manage_messages", 0x0000000000002000},
+	{"embed_links", 0x0000000000004000},
+	{"attach_files", 0x0000000000008000},
+	{"read_message_history", 0x0000000000010000},
+	{"mention_everyone", 0x0000000000020000},
+	{"use_external_emojis", 0x0000000000040000},
+	{"view_guild_insights", 0x0000000000080000},
+	{"connect", 0x0000000000100000},
+	{"speak", 0x0000000000200000},
+	{"mute_members", 0x0000000000400000},
+	{"deafen_members", 0x0000000000800000},
+	{"move_members", 0x0000000001000000},
+	{"use_vad", 0x0000000002000000},
+	{"change_nickname", 0x0000000004000000},
+	{"manage_nicknames", 0x0000000008000000},
+	{"manage_roles", 0x0000000010000000},
+	{"manage_webhooks", 0x0000000020000000},
+	{"manage_guild_expressions", 0x0000000040000000},
+	{"use_application_commands", 0x0000000080000000},
+	{"request_to_speak", 0x0000000100000000},
+	{"manage_events", 0x0000000200000000},
+	{"manage_threads", 0x0000000400000000},
+	{"create_public_threads", 0x0000000800000000},
+	{"create_private_threads", 0x0000001000000000},
+	{"use_external_stickers", 0x0000002000000000},
+	{"send_messages_in_threads", 0x0000004000000000},
+	{"use_embedded_activities", 0x0000008000000000},
+	{"moderate_members", 0x0000010000000000},
+	{"view_creator_monetization_analytics", 0x0000020000000000},
+	{"use_soundboard", 0x0000040000000000},
+	{"create_guild_expressions", 0x0000080000000000},
+	{"create_events", 0x0000100000000000},
+	{"use_external_sounds", 0x0000200000000000},
+	{"send_voice_messages", 0x0000400000000000},
+	{"set_voice_channel_status", 0x0001000000000000},
+	{"send_polls", 0x0002000000000000},
+	{"use_external_apps", 0x0004000000000000},
+	{"pin_messages", 0x0008000000000000},
+	{"bypass_slowmode", 0x0010000000000000},
+}
+
+// DefaultEveryonePermissions is the permission bitfield Discord grants the
+// @everyone role in a newly created guild.
+const DefaultEveryonePermissions = "111022861442625"
+
+// PermissionNames returns the names of all known permission flags in bit order.
+func PermissionNames() []string {
+	names := make([]string, len(Permissions))
+	for i, p := range Permissions {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// PermissionBitsFromNames converts a list of permission names to a permission
+// bitfield string. Returns an error if a name is not a known permission.
+func PermissionBitsFromNames(names []string) (string, error) {
+	var bits uint64
+	for _, name := range names {
+		found := false
+		for _, p := range Permissions {
+			if p.Name == name {
+				bits |= p.Bit
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("unknown permission name %q", name)
+		}
+	}
+	return strconv.FormatUint(bits, 10), nil
+}
+
+// UnknownPermissionBitsError is returned by PermissionNamesFromBits when a
+// bitfield contains permissions without a known name.
+type UnknownPermissionBitsError struct {
+	Bits []uint64
+}
+
+// Error implements the error interface.
+func (e *UnknownPermissionBitsError) Error() string {
+	bits := make([]string, len(e.Bits))
+	for i, b := range e.Bits {
+		bits[i] = strconv.FormatUint(b, 10)
+	}
+	return fmt.Sprintf("permission bitfield contains bits without a known permission name: %s",
+		strings.Join(bits, ", "))
+}
+
+// PermissionNamesFromBits converts a permission bitfield string to the names of
+// the permissions it contains. When some bits have no known name, the names of
+// the known bits are returned together with an *UnknownPermissionBitsError.
+func PermissionNamesFromBits(bits string) ([]string, error) {
+	if bits == "" {
+		return []string{}, nil
+	}
+	val, err := strconv.ParseUint(bits, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permission bitfield %q: %w", bits, err)
+	}
+	names := []string{}
+	for _, p := range Permissions {
+		if val&p.Bit != 0 {
+			names = append(names, p.Name)
+			val &^= p.Bit
+		}
+	}
+	if val != 0 {
+		unknown := &UnknownPermissionBitsError{}
+		for bit := uint64(1); bit != 0; bit <<= 1 {
+			if val&bit != 0 {
+				unknown.Bits = append(unknown.Bits, bit)
+			}
+		}
+		return names, unknown
+	}
+	return names, nil
+}
@@ -0,0 +1,140 @@
+package discord
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// ---------- TestPermissionBitsFromNames ----------
+
+func TestPermissionBitsFromNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		names     []string
+		expected  string
+		expectErr bool
+	}{
+		{name: "empty", names: nil, expected: "0"},
+		{name: "single", names: []string{"view_channel"}, expected: "1024"},
+		{name: "multiple", names: []string{"view_channel", "send_messages"}, expected: "3072"},
+		{name: "duplicate", names: []string{"view_channel", "view_channel"}, expected: "1024"},
+		{name: "high bit", names: []string{"send_voice_messages"}, expected: "70368744177664"},
+		{name: "unknown", names: []string{"not_a_permission"}, expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := PermissionBitsFromNames(tc.names)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestPermissionNamesFromBits ----------
+
+func TestPermissionNamesFromBits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		bits            string
+		expected        []string
+		expectedUnknown []uint64
+		expectErr       bool
+	}{
+		{name: "empty string", bits: "", expected: []string{}},
+		{name: "zero", bits: "0", expected: []string{}},
+		{name: "multiple", bits: "3072", expected: []string{"view_channel", "send_messages"}},
+		{name: "newer flags", bits: "1688849860263936", expected: []string{"send_polls", "use_external_apps"}},
+		{
+			name:            "unknown bits reported",
+			bits:            "3458764513820541952",
+			expected:        []string{"view_channel"},
+			expectedUnknown: []uint64{1 << 60, 1 << 61},
+		},
+		{name: "invalid", bits: "abc", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := PermissionNamesFromBits(tc.bits)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if tc.expectedUnknown != nil {
+				var unknown *UnknownPermissionBitsError
+				if !errors.As(err, &unknown) {
+					t.Fatalf("expected UnknownPermissionBitsError, got %v", err)
+				}
+				if !reflect.DeepEqual(unknown.Bits, tc.expectedUnknown) {
+					t.Errorf("expected unknown bits %v, got %v", tc.expectedUnknown, unknown.Bits)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestPermissionNames_RoundTrip ----------
+
+func TestPermissionNames_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	names := PermissionNames()
+	if len(names) != len(Permissions) {
+		t.Fatalf("expected %d names, got %d", len(Permissions), len(names))
+	}
+
+	bits, err := PermissionBitsFromNames(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := PermissionNamesFromBits(bits)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, names) {
+		t.Errorf("round-trip failed: expected %v, got %v", names, got)
+	}
+}
+
+// ---------- TestDefaultEveryonePermissions ----------
+
+func TestDefaultEveryonePermissions(t *testing.T) {
+	t.Parallel()
+
+	names, err := PermissionNamesFromBits(DefaultEveryonePermissions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bits, err := PermissionBitsFromNames(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bits != DefaultEveryonePermissions {
+		t.Errorf("default contains unnamed bits: expected %q, got %q", DefaultEveryonePermissions, bits)
+	}
+}
@@ -8,24 +8,26 @@ import (
 
 // CreateRoleParams are the parameters for creating a guild role.
 type CreateRoleParams struct {
-	Name         *string `json:"name,omitempty"`
-	Permissions  *string `json:"permissions,omitempty"`
-	Color        *int    `json:"color,omitempty"`
-	Hoist        *bool   `json:"hoist,omitempty"`
-	Icon         *string `json:"icon,omitempty"`
-	UnicodeEmoji *string `json:"unicode_emoji,omitempty"`
-	Mentionable  *bool   `json:"mentionable,omitempty"`
+	Name         *string     `json:"name,omitempty"`
+	Permissions  *string     `json:"permissions,omitempty"`
+	Color        *int        `json:"color,omitempty"`
+	Hoist        *bool       `json:"hoist,omitempty"`
+	Icon         *string     `json:"icon,omitempty"`
+	UnicodeEmoji *string     `json:"unicode_emoji,omitempty"`
+	Mentionable  *bool       `json:"mentionable,omitempty"`
+	Colors       *RoleColors `json:"colors,omitempty"`
 }
 
 // ModifyRoleParams are the parameters for modifying a guild role.
 type ModifyRoleParams struct {
-	Name         *string `json:"name,omitempty"`
-	Permissions  *string `json:"permissions,omitempty"`
-	Color        *int    `json:"color,omitempty"`
-	Hoist        *bool   `json:"hoist,omitempty"`
-	Icon         *string `json:"icon,omitempty"`
-	UnicodeEmoji *string `json:"unicode_emoji,omitempty"`
-	Mentionable  *bool   `json:"mentionable,omitempty"`
+	Name         *string     `json:"name,omitempty"`
+	Permissions  *string     `json:"permissions,omitempty"`
+	Color        *int        `json:"color,omitempty"`
+	Hoist        *bool       `json:"hoist,omitempty"`
+	Icon         *NullString `json:"icon,omitempty"`
+	UnicodeEmoji *string     `json:"unicode_emoji,omitempty"`
+	Mentionable  *bool       `json:"mentionable,omitempty"`
+	Colors       *RoleColors `json:"colors,omitempty"`
 }
 
 // RolePosition represents a role position update for ModifyGuildRolePositions.
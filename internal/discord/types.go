@@ -1,6 +1,9 @@
 package discord
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Snowflake is a Discord snowflake ID represented as a string.
 type Snowflake string
@@ -15,6 +18,36 @@ func (s Snowflake) IsEmpty() bool {
 	return s == "" || s == "0"
 }
 
+// NullString is an optional string field that can be sent as JSON null to
+// clear a value. Use a nil pointer to omit the field entirely.
+type NullString struct {
+	Value string
+	Null  bool
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NullString) MarshalJSON() ([]byte, error) {
+	if n.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// NullTime is an optional timestamp field that can be sent as JSON null to
+// clear a value. Use a nil pointer to omit the field entirely.
+type NullTime struct {
+	Value time.Time
+	Null  bool
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if n.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
 // Channel types
 const (
 	ChannelTypeGuildText         = 0
@@ -25,6 +58,26 @@ const (
 	ChannelTypeGuildForum        = 15
 )
 
+// Webhook types
+const (
+	WebhookTypeIncoming        = 1
+	WebhookTypeChannelFollower = 2
+)
+
+// Holographic role colors. Discord only accepts these exact values when a
+// tertiary color is set.
+const (
+	HolographicPrimaryColor   = 11127295
+	HolographicSecondaryColor = 16759788
+	HolographicTertiaryColor  = 16761760
+)
+
+// Guild features
+const (
+	GuildFeatureEnhancedRoleColors = "ENHANCED_ROLE_COLORS"
+	GuildFeatureRoleIcons          = "ROLE_ICONS"
+)
+
 // Auto-moderation trigger types
 const (
 	AutoModTriggerKeyword       = 1
@@ -218,28 +271,63 @@ type DefaultReaction struct {
 
 // Role represents a Discord role.
 type Role struct {
-	ID           Snowflake  `json:"id"`
-	Name         string     `json:"name"`
-	Color        int        `json:"color"`
-	Hoist        bool       `json:"hoist"`
-	Icon         *string    `json:"icon,omitempty"`
-	UnicodeEmoji *string    `json:"unicode_emoji,omitempty"`
-	Position     int        `json:"position"`
-	Permissions  string     `json:"permissions"`
-	Managed      bool       `json:"managed"`
-	Mentionable  bool       `json:"mentionable"`
-	Tags         *RoleTags  `json:"tags,omitempty"`
-	Flags        int        `json:"flags"`
+	ID           Snowflake   `json:"id"`
+	Name         string      `json:"name"`
+	Color        int         `json:"color"`
+	Hoist        bool        `json:"hoist"`
+	Icon         *string     `json:"icon,omitempty"`
+	UnicodeEmoji *string     `json:"unicode_emoji,omitempty"`
+	Position     int         `json:"position"`
+	Permissions  string      `json:"permissions"`
+	Managed      bool        `json:"managed"`
+	Mentionable  bool        `json:"mentionable"`
+	Tags         *RoleTags   `json:"tags,omitempty"`
+	Flags        int         `json:"flags"`
+	Colors       *RoleColors `json:"colors,omitempty"`
+}
+
+// RoleColors contains the colors of a role. A secondary color makes the role
+// a gradient; the holographic style uses the fixed holographic values.
+type RoleColors struct {
+	PrimaryColor   int  `json:"primary_color"`
+	SecondaryColor *int `json:"secondary_color"`
+	TertiaryColor  *int `json:"tertiary_color"`
 }
 
 // RoleTags contains special role tag information.
 type RoleTags struct {
 	BotID                 *Snowflake `json:"bot_id,omitempty"`
 	IntegrationID         *Snowflake `json:"integration_id,omitempty"`
-	PremiumSubscriber     *bool      `json:"premium_subscriber,omitempty"`
+	PremiumSubscriber     bool       `json:"premium_subscriber,omitempty"`
 	SubscriptionListingID *Snowflake `json:"subscription_listing_id,omitempty"`
-	AvailableForPurchase  *bool      `json:"available_for_purchase,omitempty"`
-	GuildConnections      *bool      `json:"guild_connections,omitempty"`
+	AvailableForPurchase  bool       `json:"available_for_purchase,omitempty"`
+	GuildConnections      bool       `json:"guild_connections,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Discord sends the boolean tags as
+// keys with a null value, so a tag is true when its key is present.
+func (t *RoleTags) UnmarshalJSON(data []byte) error {
+	var ids struct {
+		BotID                 *Snowflake `json:"bot_id"`
+		IntegrationID         *Snowflake `json:"integration_id"`
+		SubscriptionListingID *Snowflake `json:"subscription_listing_id"`
+	}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+
+	t.BotID = ids.BotID
+	t.IntegrationID = ids.IntegrationID
+	t.SubscriptionListingID = ids.SubscriptionListingID
+	_, t.PremiumSubscriber = keys["premium_subscriber"]
+	_, t.AvailableForPurchase = keys["available_for_purchase"]
+	_, t.GuildConnections = keys["guild_connections"]
+	return nil
 }
 
 // Member represents a guild member.
@@ -258,6 +346,14 @@ type Member struct {
 	CommunicationDisabledUntil *time.Time  `json:"communication_disabled_until,omitempty"`
 }
 
+// Guild member flags that can be modified by bots.
+const (
+	MemberFlagBypassesVerification = 1 << 2
+)
+
+// MaxMemberTimeout is the longest timeout Discord allows for a member.
+const MaxMemberTimeout = 28 * 24 * time.Hour
+
 // Ban represents a guild ban.
 type Ban struct {
 	Reason *string `json:"reason,omitempty"`
@@ -302,9 +398,17 @@ type Webhook struct {
 	Avatar        *string    `json:"avatar,omitempty"`
 	Token         *string    `json:"token,omitempty"`
 	ApplicationID *Snowflake `json:"application_id,omitempty"`
+	SourceGuild   *Guild     `json:"source_guild,omitempty"`
+	SourceChannel *Channel   `json:"source_channel,omitempty"`
 	URL           *string    `json:"url,omitempty"`
 }
 
+// FollowedChannel is returned when following an announcement channel.
+type FollowedChannel struct {
+	ChannelID Snowflake `json:"channel_id"`
+	WebhookID Snowflake `json:"webhook_id"`
+}
+
 // Invite represents a Discord invite.
 type Invite struct {
 	Code                     string             `json:"code"`
@@ -564,12 +668,83 @@ type Message struct {
 	MentionRoles    []Snowflake  `json:"mention_roles,omitempty"`
 	Attachments     []*Attachment `json:"attachments,omitempty"`
 	Embeds          []*Embed     `json:"embeds,omitempty"`
+	Components      []*Component `json:"components,omitempty"`
+	Reactions       []*Reaction  `json:"reactions,omitempty"`
+	Poll            *Poll        `json:"poll,omitempty"`
 	Pinned          bool         `json:"pinned"`
 	Type            int          `json:"type"`
 	Flags           *int         `json:"flags,omitempty"`
 	GuildID         *Snowflake   `json:"guild_id,omitempty"`
 }
 
+// Reaction counts the reactions with one emoji on a message.
+type Reaction struct {
+	Count int   `json:"count"`
+	Me    bool  `json:"me"`
+	Emoji Emoji `json:"emoji"`
+}
+
+// PollLayoutDefault is the only poll layout Discord supports.
+const PollLayoutDefault = 1
+
+// PollMedia is the text and emoji of a poll question or answer.
+type PollMedia struct {
+	Text  *string `json:"text,omitempty"`
+	Emoji *Emoji  `json:"emoji,omitempty"`
+}
+
+// PollAnswer is an answer of a poll. AnswerID is assigned by Discord.
+type PollAnswer struct {
+	AnswerID  int       `json:"answer_id,omitempty"`
+	PollMedia PollMedia `json:"poll_media"`
+}
+
+// PollCreateRequest is the poll sent when creating a message. Duration is
+// the number of hours the poll is open for.
+type PollCreateRequest struct {
+	Question         PollMedia     `json:"question"`
+	Answers          []*PollAnswer `json:"answers"`
+	Duration         *int          `json:"duration,omitempty"`
+	AllowMultiselect *bool         `json:"allow_multiselect,omitempty"`
+	LayoutType       *int          `json:"layout_type,omitempty"`
+}
+
+// Poll is a poll attached to a message.
+type Poll struct {
+	Question         PollMedia     `json:"question"`
+	Answers          []*PollAnswer `json:"answers"`
+	Expiry           *time.Time    `json:"expiry,omitempty"`
+	AllowMultiselect bool          `json:"allow_multiselect"`
+	LayoutType       int           `json:"layout_type"`
+	Results          *PollResults  `json:"results,omitempty"`
+}
+
+// PollResults are the vote counts of a poll. Answers without votes may be
+// missing from AnswerCounts.
+type PollResults struct {
+	IsFinalized  bool               `json:"is_finalized"`
+	AnswerCounts []*PollAnswerCount `json:"answer_counts"`
+}
+
+// PollAnswerCount is the number of votes for a poll answer.
+type PollAnswerCount struct {
+	ID      int  `json:"id"`
+	Count   int  `json:"count"`
+	MeVoted bool `json:"me_voted"`
+}
+
+// MessagePin represents a pinned message in a channel.
+type MessagePin struct {
+	PinnedAt time.Time `json:"pinned_at"`
+	Message  *Message  `json:"message"`
+}
+
+// ChannelPins represents a page of pinned messages in a channel.
+type ChannelPins struct {
+	Items   []*MessagePin `json:"items"`
+	HasMore bool          `json:"has_more"`
+}
+
 // Embed represents a Discord embed.
 type Embed struct {
 	Title       *string        `json:"title,omitempty"`
@@ -631,6 +806,105 @@ type EmbedField struct {
 	Inline *bool  `json:"inline,omitempty"`
 }
 
+// Message flags that can be set when sending a message.
+const (
+	MessageFlagSuppressEmbeds        = 1 << 2
+	MessageFlagSuppressNotifications = 1 << 12
+	MessageFlagIsComponentsV2        = 1 << 15
+)
+
+// MessageFlagCrossposted is set by Discord on a message that has been
+// published to the channels following its announcement channel.
+const MessageFlagCrossposted = 1 << 0
+
+// Allowed mention types for AllowedMentions.Parse.
+const (
+	AllowedMentionRoles    = "roles"
+	AllowedMentionUsers    = "users"
+	AllowedMentionEveryone = "everyone"
+)
+
+// AllowedMentions controls which mentions in a message notify their targets.
+// Parse is always sent so that an empty list suppresses every mention.
+type AllowedMentions struct {
+	Parse       []string    `json:"parse"`
+	Roles       []Snowflake `json:"roles,omitempty"`
+	Users       []Snowflake `json:"users,omitempty"`
+	RepliedUser *bool       `json:"replied_user,omitempty"`
+}
+
+// Component types.
+const (
+	ComponentTypeActionRow         = 1
+	ComponentTypeButton            = 2
+	ComponentTypeStringSelect      = 3
+	ComponentTypeUserSelect        = 5
+	ComponentTypeRoleSelect        = 6
+	ComponentTypeMentionableSelect = 7
+	ComponentTypeChannelSelect     = 8
+	ComponentTypeSection           = 9
+	ComponentTypeTextDisplay       = 10
+	ComponentTypeThumbnail         = 11
+	ComponentTypeSeparator         = 14
+	ComponentTypeContainer         = 17
+)
+
+// Button styles.
+const (
+	ButtonStylePrimary   = 1
+	ButtonStyleSecondary = 2
+	ButtonStyleSuccess   = 3
+	ButtonStyleDanger    = 4
+	ButtonStyleLink      = 5
+)
+
+// Separator spacing sizes.
+const (
+	SeparatorSpacingSmall = 1
+	SeparatorSpacingLarge = 2
+)
+
+// Component is an interactive or layout component attached to a message.
+// Which fields apply depends on Type.
+type Component struct {
+	Type         int                `json:"type"`
+	ID           *int               `json:"id,omitempty"`
+	CustomID     *string            `json:"custom_id,omitempty"`
+	Style        *int               `json:"style,omitempty"`
+	Label        *string            `json:"label,omitempty"`
+	Emoji        *Emoji             `json:"emoji,omitempty"`
+	URL          *string            `json:"url,omitempty"`
+	Disabled     *bool              `json:"disabled,omitempty"`
+	Placeholder  *string            `json:"placeholder,omitempty"`
+	MinValues    *int               `json:"min_values,omitempty"`
+	MaxValues    *int               `json:"max_values,omitempty"`
+	Options      []*SelectOption    `json:"options,omitempty"`
+	ChannelTypes []int              `json:"channel_types,omitempty"`
+	Components   []*Component       `json:"components,omitempty"`
+	Accessory    *Component         `json:"accessory,omitempty"`
+	Content      *string            `json:"content,omitempty"`
+	Media        *UnfurledMediaItem `json:"media,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	Spoiler      *bool              `json:"spoiler,omitempty"`
+	Divider      *bool              `json:"divider,omitempty"`
+	Spacing      *int               `json:"spacing,omitempty"`
+	AccentColor  *int               `json:"accent_color,omitempty"`
+}
+
+// SelectOption is an option in a string select menu.
+type SelectOption struct {
+	Label       string  `json:"label"`
+	Value       string  `json:"value"`
+	Description *string `json:"description,omitempty"`
+	Emoji       *Emoji  `json:"emoji,omitempty"`
+	Default     *bool   `json:"default,omitempty"`
+}
+
+// UnfurledMediaItem references media by URL in a layout component.
+type UnfurledMediaItem struct {
+	URL string `json:"url"`
+}
+
 // Attachment represents a message attachment.
 type Attachment struct {
 	ID          Snowflake `json:"id"`
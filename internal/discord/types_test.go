@@ -2,7 +2,9 @@ package discord
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
+	"time"
 )
 
 // ---------- TestSnowflake_String ----------
@@ -100,6 +102,108 @@ func TestSnowflake_MarshalJSON(t *testing.T) {
 	}
 }
 
+// ---------- TestNullString_MarshalJSON ----------
+
+func TestNullString_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type wrapper struct {
+		Icon *NullString `json:"icon,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		input    wrapper
+		expected string
+	}{
+		{name: "omitted", input: wrapper{}, expected: `{}`},
+		{name: "null", input: wrapper{Icon: &NullString{Null: true}}, expected: `{"icon":null}`},
+		{name: "value", input: wrapper{Icon: &NullString{Value: "data:image/png;base64,AA=="}}, expected: `{"icon":"data:image/png;base64,AA=="}`},
+		{name: "empty value", input: wrapper{Icon: &NullString{}}, expected: `{"icon":""}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := string(data)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestNullTime_MarshalJSON ----------
+
+func TestNullTime_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type wrapper struct {
+		Until *NullTime `json:"until,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		input    wrapper
+		expected string
+	}{
+		{name: "omitted", input: wrapper{}, expected: `{}`},
+		{name: "null", input: wrapper{Until: &NullTime{Null: true}}, expected: `{"until":null}`},
+		{name: "value", input: wrapper{Until: &NullTime{Value: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}}, expected: `{"until":"2026-01-02T03:04:05Z"}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := string(data)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestModifyMemberParams_MarshalJSON ----------
+
+func TestModifyMemberParams_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	empty := []Snowflake{}
+	some := []Snowflake{"123", "456"}
+
+	tests := []struct {
+		name     string
+		input    ModifyMemberParams
+		expected string
+	}{
+		{name: "omitted", input: ModifyMemberParams{}, expected: `{}`},
+		{name: "empty", input: ModifyMemberParams{Roles: &empty}, expected: `{"roles":[]}`},
+		{name: "roles", input: ModifyMemberParams{Roles: &some}, expected: `{"roles":["123","456"]}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := string(data)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
 // ---------- TestSnowflake_UnmarshalJSON ----------
 
 func TestSnowflake_UnmarshalJSON(t *testing.T) {
@@ -188,3 +292,145 @@ func TestSnowflake_InStruct(t *testing.T) {
 		t.Error("expected GuildID to be 999888777666555444")
 	}
 }
+
+// ---------- TestRoleTags_UnmarshalJSON ----------
+
+func TestRoleTags_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	botID := Snowflake("111")
+	integrationID := Snowflake("222")
+
+	tests := []struct {
+		name     string
+		input    string
+		expected RoleTags
+	}{
+		{name: "empty", input: `{}`, expected: RoleTags{}},
+		{name: "bot", input: `{"bot_id":"111"}`, expected: RoleTags{BotID: &botID}},
+		{name: "integration", input: `{"integration_id":"222"}`, expected: RoleTags{IntegrationID: &integrationID}},
+		{name: "booster", input: `{"premium_subscriber":null}`, expected: RoleTags{PremiumSubscriber: true}},
+		{
+			name:     "purchasable linked role",
+			input:    `{"available_for_purchase":null,"guild_connections":null}`,
+			expected: RoleTags{AvailableForPurchase: true, GuildConnections: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var got RoleTags
+			if err := json.Unmarshal([]byte(tc.input), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestEditMessageParams_MarshalJSON ----------
+
+func TestEditMessageParams_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	empty := []*Component{}
+	row := []*Component{{Type: ComponentTypeActionRow}}
+
+	tests := []struct {
+		name     string
+		input    EditMessageParams
+		expected string
+	}{
+		{name: "omitted", input: EditMessageParams{}, expected: `{}`},
+		{name: "empty", input: EditMessageParams{Components: &empty}, expected: `{"components":[]}`},
+		{name: "components", input: EditMessageParams{Components: &row}, expected: `{"components":[{"type":1}]}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := string(data)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// ---------- TestMessage_UnmarshalComponents ----------
+
+func TestMessage_UnmarshalComponents(t *testing.T) {
+	t.Parallel()
+
+	input := `{"id":"1","flags":32768,"components":[{"type":17,"id":1,"accent_color":null,"components":[` +
+		`{"type":9,"id":2,"components":[{"type":10,"id":3,"content":"Pick a role"}],` +
+		`"accessory":{"type":2,"id":4,"style":3,"label":"Join","custom_id":"join","emoji":{"id":null,"name":"✅"}}},` +
+		`{"type":1,"id":5,"components":[{"type":6,"id":6,"custom_id":"roles","min_values":0,"max_values":3}]}]}]}`
+
+	var msg Message
+	if err := json.Unmarshal([]byte(input), &msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if msg.Flags == nil || *msg.Flags&MessageFlagIsComponentsV2 == 0 {
+		t.Errorf("expected IS_COMPONENTS_V2 flag, got %v", msg.Flags)
+	}
+	if len(msg.Components) != 1 || msg.Components[0].Type != ComponentTypeContainer {
+		t.Fatalf("expected a single container, got %+v", msg.Components)
+	}
+	if msg.Components[0].AccentColor != nil {
+		t.Errorf("expected nil accent color, got %v", *msg.Components[0].AccentColor)
+	}
+
+	section := msg.Components[0].Components[0]
+	if got := *section.Components[0].Content; got != "Pick a role" {
+		t.Errorf("expected section text %q, got %q", "Pick a role", got)
+	}
+	if a := section.Accessory; a == nil || *a.CustomID != "join" || *a.Style != ButtonStyleSuccess || *a.Emoji.Name != "✅" || a.Emoji.ID != nil {
+		t.Errorf("unexpected accessory: %+v", a)
+	}
+
+	sel := msg.Components[0].Components[1].Components[0]
+	if sel.Type != ComponentTypeRoleSelect || *sel.MinValues != 0 || *sel.MaxValues != 3 {
+		t.Errorf("unexpected select: %+v", sel)
+	}
+}
+
+// ---------- TestAllowedMentions_MarshalJSON ----------
+
+func TestAllowedMentions_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	replied := false
+
+	tests := []struct {
+		name     string
+		input    AllowedMentions
+		expected string
+	}{
+		{name: "suppress all", input: AllowedMentions{Parse: []string{}}, expected: `{"parse":[]}`},
+		{name: "parse types", input: AllowedMentions{Parse: []string{AllowedMentionUsers, AllowedMentionEveryone}}, expected: `{"parse":["users","everyone"]}`},
+		{name: "explicit roles", input: AllowedMentions{Parse: []string{}, Roles: []Snowflake{"123"}, RepliedUser: &replied}, expected: `{"parse":[],"roles":["123"],"replied_user":false}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := string(data)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
 )
 
 // CreateWebhookParams are the parameters for creating a webhook.
@@ -30,6 +32,17 @@ func (c *Client) CreateWebhook(ctx context.Context, channelID Snowflake, params
 	return webhook, nil
 }
 
+// GetChannelWebhooks returns a list of webhooks for a channel.
+func (c *Client) GetChannelWebhooks(ctx context.Context, channelID Snowflake) ([]*Webhook, error) {
+	var webhooks []*Webhook
+	route := fmt.Sprintf("/channels/%s/webhooks", channelID)
+	err := c.doRequest(ctx, http.MethodGet, route, nil, &webhooks)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
 // GetWebhook returns the webhook object for the given ID.
 func (c *Client) GetWebhook(ctx context.Context, webhookID Snowflake) (*Webhook, error) {
 	webhook := new(Webhook)
@@ -58,3 +71,88 @@ func (c *Client) DeleteWebhook(ctx context.Context, webhookID Snowflake) error {
 	return c.doRequestNoContent(ctx, http.MethodDelete, route, nil)
 }
 
+// webhookURLPattern matches webhook URLs, capturing the webhook ID and token.
+var webhookURLPattern = regexp.MustCompile(`^https://(?:(?:canary|ptb)\.)?discord(?:app)?\.com/api(?:/v\d+)?/webhooks/(\d+)/([\w-]+)/?$`)
+
+// ParseWebhookURL returns the webhook ID and token from a webhook URL such as
+// https://discord.com/api/webhooks/{id}/{token}.
+func ParseWebhookURL(raw string) (Snowflake, string, error) {
+	m := webhookURLPattern.FindStringSubmatch(raw)
+	if m == nil {
+		return "", "", fmt.Errorf("expected a webhook URL of the form https://discord.com/api/webhooks/{id}/{token}")
+	}
+	return Snowflake(m[1]), m[2], nil
+}
+
+// ExecuteWebhookParams are the parameters for executing a webhook.
+type ExecuteWebhookParams struct {
+	Content         *string          `json:"content,omitempty"`
+	Username        *string          `json:"username,omitempty"`
+	AvatarURL       *string          `json:"avatar_url,omitempty"`
+	Embeds          []*Embed         `json:"embeds,omitempty"`
+	AllowedMentions *AllowedMentions `json:"allowed_mentions,omitempty"`
+}
+
+// EditWebhookMessageParams are the parameters for editing a message sent by a
+// webhook. Embeds is a pointer so that an empty list can be sent to remove
+// every embed from the message.
+type EditWebhookMessageParams struct {
+	Content         *string          `json:"content,omitempty"`
+	Embeds          *[]*Embed        `json:"embeds,omitempty"`
+	AllowedMentions *AllowedMentions `json:"allowed_mentions,omitempty"`
+}
+
+// webhookRoute returns the route of a webhook authenticated by its token,
+// with the thread_id query parameter when threadID is set.
+func webhookRoute(webhookID Snowflake, token, suffix string, query url.Values, threadID Snowflake) string {
+	route := fmt.Sprintf("/webhooks/%s/%s%s", webhookID, url.PathEscape(token), suffix)
+	if threadID != "" {
+		query.Set("thread_id", threadID.String())
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+	return route
+}
+
+// ExecuteWebhook sends a message through a webhook and returns the created
+// message. The webhook token authenticates the request, so no bot token is
+// needed. When threadID is set the message is sent to that thread of the
+// webhook's channel.
+func (c *Client) ExecuteWebhook(ctx context.Context, webhookID Snowflake, token string, threadID Snowflake, params *ExecuteWebhookParams) (*Message, error) {
+	msg := new(Message)
+	route := webhookRoute(webhookID, token, "", url.Values{"wait": {"true"}}, threadID)
+	err := c.doRequest(ctx, http.MethodPost, route, params, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// GetWebhookMessage returns a message previously sent by a webhook.
+func (c *Client) GetWebhookMessage(ctx context.Context, webhookID Snowflake, token string, messageID, threadID Snowflake) (*Message, error) {
+	msg := new(Message)
+	route := webhookRoute(webhookID, token, "/messages/"+messageID.String(), url.Values{}, threadID)
+	err := c.doRequest(ctx, http.MethodGet, route, nil, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// EditWebhookMessage edits a message previously sent by a webhook.
+func (c *Client) EditWebhookMessage(ctx context.Context, webhookID Snowflake, token string, messageID, threadID Snowflake, params *EditWebhookMessageParams) (*Message, error) {
+	msg := new(Message)
+	route := webhookRoute(webhookID, token, "/messages/"+messageID.String(), url.Values{}, threadID)
+	err := c.doRequest(ctx, http.MethodPatch, route, params, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// DeleteWebhookMessage deletes a message previously sent by a webhook.
+func (c *Client) DeleteWebhookMessage(ctx context.Context, webhookID Snowflake, token string, messageID, threadID Snowflake) error {
+	route := webhookRoute(webhookID, token, "/messages/"+messageID.String(), url.Values{}, threadID)
+	return c.doRequestNoContent(ctx, http.MethodDelete, route, nil)
+}
@@ -0,0 +1,116 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// ---------- TestParseWebhookURL ----------
+
+func TestParseWebhookURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		raw     string
+		id      Snowflake
+		token   string
+		wantErr bool
+	}{
+		{name: "discord.com", raw: "https://discord.com/api/webhooks/123/abc-DEF_1", id: "123", token: "abc-DEF_1"},
+		{name: "versioned", raw: "https://discord.com/api/v10/webhooks/123/abc", id: "123", token: "abc"},
+		{name: "canary discordapp.com", raw: "https://canary.discordapp.com/api/webhooks/123/abc/", id: "123", token: "abc"},
+		{name: "missing token", raw: "https://discord.com/api/webhooks/123", wantErr: true},
+		{name: "other host", raw: "https://example.com/api/webhooks/123/abc", wantErr: true},
+		{name: "non-numeric ID", raw: "https://discord.com/api/webhooks/abc/def", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, token, err := ParseWebhookURL(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q", tc.raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != tc.id || token != tc.token {
+				t.Errorf("expected (%q, %q), got (%q, %q)", tc.id, tc.token, id, token)
+			}
+		})
+	}
+}
+
+// ---------- TestExecuteWebhook ----------
+
+func TestExecuteWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		threadID Snowflake
+		query    string
+	}{
+		{name: "channel", query: "wait=true"},
+		{name: "thread", threadID: "20", query: "thread_id=20&wait=true"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/webhooks/10/tok" {
+					t.Errorf("expected path /webhooks/10/tok, got %q", r.URL.Path)
+				}
+				if r.URL.RawQuery != tc.query {
+					t.Errorf("expected query %q, got %q", tc.query, r.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(Message{ID: "1"})
+			})
+			defer server.Close()
+
+			content := "hello"
+			msg, err := client.ExecuteWebhook(context.Background(), "10", "tok", tc.threadID, &ExecuteWebhookParams{Content: &content})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if msg.ID != "1" {
+				t.Errorf("expected message ID %q, got %q", "1", msg.ID)
+			}
+		})
+	}
+}
+
+// ---------- TestWebhookMessage_WithoutToken ----------
+
+func TestWebhookMessage_WithoutToken(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no Authorization header, got %q", auth)
+		}
+		if r.URL.Path != "/webhooks/10/tok/messages/1" {
+			t.Errorf("expected path /webhooks/10/tok/messages/1, got %q", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+	client.token = ""
+
+	if client.HasToken() {
+		t.Error("expected HasToken to be false")
+	}
+	if err := client.DeleteWebhookMessage(context.Background(), "10", "tok", "1", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -78,7 +78,8 @@ func (p *discordProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 		Attributes: map[string]schema.Attribute{
 			"token": schema.StringAttribute{
 				Description: "The Discord bot token used to authenticate API requests. " +
-					"Can also be set via the DISCORD_TOKEN environment variable.",
+					"Can also be set via the DISCORD_TOKEN environment variable. " +
+					"Required by every resource and data source except `discord_webhook_message`.",
 				Optional:  true,
 				Sensitive: true,
 			},
@@ -106,22 +107,15 @@ func (p *discordProvider) Configure(ctx context.Context, req provider.ConfigureR
 		token = config.Token.ValueString()
 	}
 
-	if token == "" {
-		resp.Diagnostics.AddError(
-			"Missing Discord Bot Token",
-			"The provider requires a Discord bot token to authenticate API requests. "+
-				"Set the token in the provider configuration block or via the DISCORD_TOKEN environment variable.",
-		)
-		return
-	}
-
 	// Resolve the application ID: config value takes precedence, then env var.
 	applicationID := os.Getenv("DISCORD_APPLICATION_ID")
 	if !config.ApplicationID.IsNull() {
 		applicationID = config.ApplicationID.ValueString()
 	}
 
-	// Create the Discord REST client.
+	// Create the Discord REST client. Without a token the client can only
+	// execute webhooks; resources that need the bot report the missing token
+	// when they are configured.
 	client := discord.NewClient(token, p.version)
 
 	// Store the client and application ID so resources and data sources can
@@ -141,10 +135,19 @@ func (p *discordProvider) Resources(_ context.Context) []func() resource.Resourc
 		guild.NewGuildResource,
 		channel.NewChannelResource,
 		channel.NewChannelPermissionResource,
+		channel.NewChannelPermissionsResource,
+		channel.NewChannelFollowerResource,
 		role.NewRoleResource,
+		role.NewRoleOrderResource,
+		role.NewEveryoneRoleResource,
+		member.NewMemberResource,
+		member.NewMemberRoleResource,
 		member.NewMemberRolesResource,
+		member.NewRoleMembersResource,
 		soundboard.NewSoundboardSoundResource,
 		message.NewMessageResource,
+		message.NewMessageReactionResource,
+		message.NewWebhookMessageResource,
 		widget.NewGuildWidgetResource,
 		webhook.NewWebhookResource,
 		invite.NewInviteResource,
@@ -171,6 +174,10 @@ func (p *discordProvider) DataSources(_ context.Context) []func() datasource.Dat
 		guild.NewColorDataSource,
 		channel.NewChannelDataSource,
 		role.NewRoleDataSource,
+		role.NewRolesDataSource,
+		member.NewGuildMembersDataSource,
+		message.NewMessageDataSource,
+		message.NewMessagesDataSource,
 		user.NewUserDataSource,
 		voice.NewVoiceRegionsDataSource,
 	}
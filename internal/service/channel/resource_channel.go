@@ -52,6 +52,23 @@ func NewChannelResource() resource.Resource {
 	return &channelResource{}
 }
 
+// isConvertibleChannelType reports whether Discord can convert a channel of
+// this type in place through Modify Channel.
+func isConvertibleChannelType(t int64) bool {
+	return t == discord.ChannelTypeGuildText || t == discord.ChannelTypeGuildAnnouncement
+}
+
+// channelTypeRequiresReplace forces replacement for type changes other than
+// text <-> announcement conversions.
+func channelTypeRequiresReplace(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+	resp.RequiresReplace = !isConvertibleChannelType(req.StateValue.ValueInt64()) ||
+		!isConvertibleChannelType(req.PlanValue.ValueInt64())
+}
+
 // Metadata returns the resource type name.
 func (r *channelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_channel"
@@ -86,10 +103,15 @@ func (r *channelResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:    true,
 			},
 			"type": schema.Int64Attribute{
-				Description: "The type of channel (0=text, 2=voice, 4=category, 5=announcement, 13=stage, 15=forum, 16=media).",
-				Required:    true,
+				Description: "The type of channel (0=text, 2=voice, 4=category, 5=announcement, 13=stage, 15=forum, 16=media). " +
+					"Text and announcement channels can be converted into each other in place; any other type change recreates the channel.",
+				Required: true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIf(
+						channelTypeRequiresReplace,
+						"Requires replacement unless converting between text (0) and announcement (5) channels.",
+						"Requires replacement unless converting between text (`0`) and announcement (`5`) channels.",
+					),
 				},
 			},
 			"position": schema.Int64Attribute{
@@ -282,6 +304,11 @@ func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 	name := plan.Name.ValueString()
 	params.Name = &name
 
+	if !plan.Type.Equal(state.Type) {
+		v := int(plan.Type.ValueInt64())
+		params.Type = &v
+	}
+
 	if !plan.Topic.IsNull() {
 		v := plan.Topic.ValueString()
 		params.Topic = &v
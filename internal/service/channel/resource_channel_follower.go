@@ -0,0 +1,237 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &channelFollowerResource{}
+	_ resource.ResourceWithConfigure   = &channelFollowerResource{}
+	_ resource.ResourceWithImportState = &channelFollowerResource{}
+)
+
+// channelFollowerResource is the resource implementation.
+type channelFollowerResource struct {
+	client *discord.Client
+}
+
+// channelFollowerResourceModel maps the resource schema to a Go struct.
+type channelFollowerResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ChannelID        types.String `tfsdk:"channel_id"`
+	WebhookChannelID types.String `tfsdk:"webhook_channel_id"`
+	WebhookID        types.String `tfsdk:"webhook_id"`
+}
+
+// NewChannelFollowerResource returns a new channel follower resource.
+func NewChannelFollowerResource() resource.Resource {
+	return &channelFollowerResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *channelFollowerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_follower"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *channelFollowerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the resource.
+func (r *channelFollowerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Follows a Discord announcement channel so its published messages are relayed to a target channel. " +
+			"Discord creates a channel follower webhook in the target channel; destroying this resource deletes that webhook.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the channel follower webhook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the announcement channel (type 5) to follow.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_channel_id": schema.StringAttribute{
+				Description: "The ID of the target channel that will receive the announcements.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_id": schema.StringAttribute{
+				Description: "The ID of the webhook created in the target channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create follows the announcement channel.
+func (r *channelFollowerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelFollowerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := discord.Snowflake(plan.ChannelID.ValueString())
+
+	ch, err := r.client.GetChannel(ctx, channelID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Channel",
+			"Could not read channel ID "+plan.ChannelID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if ch.Type != discord.ChannelTypeGuildAnnouncement {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel_id"),
+			"Invalid Channel Type",
+			fmt.Sprintf("Only announcement channels (type %d) can be followed, channel %s has type %d.",
+				discord.ChannelTypeGuildAnnouncement, plan.ChannelID.ValueString(), ch.Type),
+		)
+		return
+	}
+
+	followed, err := r.client.FollowAnnouncementChannel(ctx, channelID, &discord.FollowAnnouncementChannelParams{
+		WebhookChannelID: discord.Snowflake(plan.WebhookChannelID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Following Discord Channel",
+			"Could not follow channel ID "+plan.ChannelID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(followed.WebhookID.String())
+	plan.WebhookID = types.StringValue(followed.WebhookID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *channelFollowerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelFollowerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := r.client.GetWebhook(ctx, discord.Snowflake(state.WebhookID.ValueString()))
+	if err != nil {
+		if discord.IsNotFound(err) {
+			// The follow webhook was deleted externally.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Channel Follower",
+			"Could not read webhook ID "+state.WebhookID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(webhook.ID.String())
+	state.WebhookID = types.StringValue(webhook.ID.String())
+	if webhook.ChannelID != nil {
+		state.WebhookChannelID = types.StringValue(webhook.ChannelID.String())
+	}
+	if webhook.SourceChannel != nil {
+		state.ChannelID = types.StringValue(webhook.SourceChannel.ID.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is not supported; all attributes require replacement.
+func (r *channelFollowerResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"discord_channel_follower does not support in-place updates. All changes require replacement.",
+	)
+}
+
+// Delete unfollows the announcement channel by deleting the follower webhook.
+func (r *channelFollowerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelFollowerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWebhook(ctx, discord.Snowflake(state.WebhookID.ValueString()))
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Discord Channel Follower",
+			"Could not delete webhook ID "+state.WebhookID.ValueString()+": "+err.Error(),
+		)
+	}
+}
+
+// ImportState allows importing an existing follow by webhook_channel_id/channel_id.
+// The follower webhook is looked up among the target channel's webhooks.
+func (r *channelFollowerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'webhook_channel_id/channel_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	webhooks, err := r.client.GetChannelWebhooks(ctx, discord.Snowflake(parts[0]))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Channel Webhooks",
+			"Could not read webhooks for channel ID "+parts[0]+": "+err.Error(),
+		)
+		return
+	}
+
+	var found *discord.Webhook
+	for _, w := range webhooks {
+		if w.Type == discord.WebhookTypeChannelFollower && w.SourceChannel != nil && w.SourceChannel.ID.String() == parts[1] {
+			found = w
+			break
+		}
+	}
+	if found == nil {
+		resp.Diagnostics.AddError(
+			"Channel Follower Not Found",
+			fmt.Sprintf("Channel %s does not follow announcement channel %s.", parts[0], parts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), found.ID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_id"), found.ID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_channel_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), parts[1])...)
+}
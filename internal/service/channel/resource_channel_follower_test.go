@@ -0,0 +1,69 @@
+package channel_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccChannelFollower_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccChannelFollowerConfig_basic(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_channel_follower.test", "channel_id", "discord_channel.follower_source", "id"),
+					resource.TestCheckResourceAttrPair("discord_channel_follower.test", "webhook_channel_id", "discord_channel.follower_target", "id"),
+					resource.TestCheckResourceAttrSet("discord_channel_follower.test", "webhook_id"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_channel_follower.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateChannelFollower("discord_channel_follower.test"),
+			},
+		},
+	})
+}
+
+func importStateChannelFollower(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["webhook_channel_id"], rs.Primary.Attributes["channel_id"]), nil
+	}
+}
+
+func testAccChannelFollowerConfig_basic(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "follower_source" {
+  guild_id = %[1]q
+  name     = "tf-acc-follower-source"
+  type     = 5
+}
+
+resource "discord_channel" "follower_target" {
+  guild_id = %[1]q
+  name     = "tf-acc-follower-target"
+  type     = 0
+}
+
+resource "discord_channel_follower" "test" {
+  channel_id         = discord_channel.follower_source.id
+  webhook_channel_id = discord_channel.follower_target.id
+}
+`, guildID)
+}
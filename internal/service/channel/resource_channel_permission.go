@@ -7,11 +7,14 @@ import (
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
 	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,6 +23,7 @@ var (
 	_ resource.Resource                = &channelPermissionResource{}
 	_ resource.ResourceWithConfigure   = &channelPermissionResource{}
 	_ resource.ResourceWithImportState = &channelPermissionResource{}
+	_ resource.ResourceWithModifyPlan  = &channelPermissionResource{}
 )
 
 // channelPermissionResource is the resource implementation.
@@ -29,12 +33,14 @@ type channelPermissionResource struct {
 
 // channelPermissionResourceModel maps the resource schema to a Go struct.
 type channelPermissionResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	ChannelID   types.String `tfsdk:"channel_id"`
-	OverwriteID types.String `tfsdk:"overwrite_id"`
-	Type        types.Int64  `tfsdk:"type"`
-	Allow       types.String `tfsdk:"allow"`
-	Deny        types.String `tfsdk:"deny"`
+	ID               types.String `tfsdk:"id"`
+	ChannelID        types.String `tfsdk:"channel_id"`
+	OverwriteID      types.String `tfsdk:"overwrite_id"`
+	Type             types.Int64  `tfsdk:"type"`
+	Allow            types.String `tfsdk:"allow"`
+	Deny             types.String `tfsdk:"deny"`
+	AllowPermissions types.Set    `tfsdk:"allow_permissions"`
+	DenyPermissions  types.Set    `tfsdk:"deny_permissions"`
 }
 
 // NewChannelPermissionResource returns a new channel permission resource.
@@ -83,19 +89,56 @@ func (r *channelPermissionResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:    true,
 			},
 			"allow": schema.StringAttribute{
-				Description: "The bitwise value of all allowed permissions.",
+				Description: "The bitwise value of all allowed permissions. Conflicts with allow_permissions.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("allow_permissions")),
+				},
 			},
 			"deny": schema.StringAttribute{
-				Description: "The bitwise value of all denied permissions.",
+				Description: "The bitwise value of all denied permissions. Conflicts with deny_permissions.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("deny_permissions")),
+				},
+			},
+			"allow_permissions": schema.SetAttribute{
+				Description: "The allowed permissions as names from the discord_permission data source " +
+					"(e.g. view_channel, send_messages). Conflicts with allow.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					common.PermissionNamesValidator(),
+				},
+			},
+			"deny_permissions": schema.SetAttribute{
+				Description: "The denied permissions as names from the discord_permission data source " +
+					"(e.g. view_channel, send_messages). Conflicts with deny.",
 				Optional:    true,
 				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					common.PermissionNamesValidator(),
+				},
 			},
 		},
 	}
 }
 
+// ModifyPlan keeps the raw allow/deny bitfields and their named permission sets
+// in sync so plans show named permission changes.
+func (r *channelPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(common.ModifyPermissionPlan(ctx, req.Config, &resp.Plan, path.Root("allow"), path.Root("allow_permissions"))...)
+	resp.Diagnostics.Append(common.ModifyPermissionPlan(ctx, req.Config, &resp.Plan, path.Root("deny"), path.Root("deny_permissions"))...)
+}
+
 // Create creates the channel permission overwrite.
 func (r *channelPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan channelPermissionResourceModel
@@ -138,6 +181,11 @@ func (r *channelPermissionResource) Create(ctx context.Context, req resource.Cre
 		plan.Deny = types.StringValue("0")
 	}
 
+	resp.Diagnostics.Append(setPermissionNames(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -181,6 +229,11 @@ func (r *channelPermissionResource) Read(ctx context.Context, req resource.ReadR
 	state.Allow = types.StringValue(found.Allow)
 	state.Deny = types.StringValue(found.Deny)
 
+	resp.Diagnostics.Append(setPermissionNames(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -231,6 +284,11 @@ func (r *channelPermissionResource) Update(ctx context.Context, req resource.Upd
 		plan.Deny = types.StringValue("0")
 	}
 
+	resp.Diagnostics.Append(setPermissionNames(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -272,3 +330,18 @@ func (r *channelPermissionResource) ImportState(ctx context.Context, req resourc
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite_id"), parts[1])...)
 }
+
+// setPermissionNames derives the named permission sets from the allow and deny
+// bitfields of the model.
+func setPermissionNames(ctx context.Context, m *channelPermissionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allow, d := common.PermissionNamesSetFromBits(ctx, m.Allow.ValueString())
+	diags.Append(d...)
+	deny, d := common.PermissionNamesSetFromBits(ctx, m.Deny.ValueString())
+	diags.Append(d...)
+
+	m.AllowPermissions = allow
+	m.DenyPermissions = deny
+	return diags
+}
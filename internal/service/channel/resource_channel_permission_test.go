@@ -58,3 +58,55 @@ resource "discord_channel_permission" "test" {
 }
 `, guildID)
 }
+
+func TestAccChannelPermission_permissionNames(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccChannelPermissionConfig_permissionNames(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_channel_permission.test", "allow", "3072"),
+					resource.TestCheckResourceAttr("discord_channel_permission.test", "deny", "8192"),
+					resource.TestCheckResourceAttr("discord_channel_permission.test", "allow_permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("discord_channel_permission.test", "allow_permissions.*", "view_channel"),
+					resource.TestCheckTypeSetElemAttr("discord_channel_permission.test", "allow_permissions.*", "send_messages"),
+					resource.TestCheckTypeSetElemAttr("discord_channel_permission.test", "deny_permissions.*", "manage_messages"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_channel_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccChannelPermissionConfig_permissionNames(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "perm_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-perm-names-test"
+  type     = 0
+}
+
+resource "discord_role" "perm_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-perm-names-role"
+}
+
+resource "discord_channel_permission" "test" {
+  channel_id        = discord_channel.perm_test.id
+  overwrite_id      = discord_role.perm_test.id
+  type              = 0
+  allow_permissions = ["view_channel", "send_messages"]
+  deny_permissions  = ["manage_messages"]
+}
+`, guildID)
+}
@@ -0,0 +1,385 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &channelPermissionsResource{}
+	_ resource.ResourceWithConfigure      = &channelPermissionsResource{}
+	_ resource.ResourceWithImportState    = &channelPermissionsResource{}
+	_ resource.ResourceWithValidateConfig = &channelPermissionsResource{}
+)
+
+// channelPermissionsResource is the resource implementation.
+type channelPermissionsResource struct {
+	client *discord.Client
+}
+
+// channelPermissionsResourceModel maps the resource schema to a Go struct.
+type channelPermissionsResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ChannelID  types.String `tfsdk:"channel_id"`
+	Overwrites types.Set    `tfsdk:"overwrites"`
+}
+
+// overwriteModel maps a single permission overwrite entry.
+type overwriteModel struct {
+	OverwriteID types.String `tfsdk:"overwrite_id"`
+	Type        types.Int64  `tfsdk:"type"`
+	Allow       types.String `tfsdk:"allow"`
+	Deny        types.String `tfsdk:"deny"`
+}
+
+func overwriteAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"overwrite_id": types.StringType,
+		"type":         types.Int64Type,
+		"allow":        types.StringType,
+		"deny":         types.StringType,
+	}
+}
+
+// NewChannelPermissionsResource returns a new authoritative channel permissions resource.
+func NewChannelPermissionsResource() resource.Resource {
+	return &channelPermissionsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *channelPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_permissions"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *channelPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the resource.
+func (r *channelPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages all permission overwrites on a Discord channel. " +
+			"Overwrites that are not listed are removed from the channel. " +
+			"Do not use together with discord_channel_permission on the same channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overwrites": schema.SetNestedAttribute{
+				Description: "The complete set of permission overwrites for the channel.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"overwrite_id": schema.StringAttribute{
+							Description: "The ID of the role or user for the permission overwrite.",
+							Required:    true,
+						},
+						"type": schema.Int64Attribute{
+							Description: "The type of the permission overwrite (0=role, 1=member).",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.OneOf(0, 1),
+							},
+						},
+						"allow": schema.StringAttribute{
+							Description: "The bitwise value of all allowed permissions. Defaults to \"0\".",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("0"),
+						},
+						"deny": schema.StringAttribute{
+							Description: "The bitwise value of all denied permissions. Defaults to \"0\".",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("0"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects overwrites that target the same role or user more
+// than once, since a channel holds a single overwrite per ID.
+func (r *channelPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var overwrites types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("overwrites"), &overwrites)...)
+	if resp.Diagnostics.HasError() || overwrites.IsNull() || overwrites.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]bool, len(overwrites.Elements()))
+	for _, elem := range overwrites.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		var ow overwriteModel
+		resp.Diagnostics.Append(obj.As(ctx, &ow, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if ow.OverwriteID.IsNull() || ow.OverwriteID.IsUnknown() {
+			continue
+		}
+		id := ow.OverwriteID.ValueString()
+		if seen[id] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("overwrites").AtSetValue(elem).AtName("overwrite_id"),
+				"Duplicate Overwrite ID",
+				fmt.Sprintf("overwrite_id %q is used more than once. Each role or user can have only one overwrite.", id),
+			)
+		}
+		seen[id] = true
+	}
+}
+
+// Create applies the configured overwrites and removes all others.
+func (r *channelPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := discord.Snowflake(plan.ChannelID.ValueString())
+	resp.Diagnostics.Append(r.syncOverwrites(ctx, channelID, plan.Overwrites)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ch, err := r.client.GetChannel(ctx, channelID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Channel Permissions",
+			"Could not read channel ID "+plan.ChannelID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenOverwrites(ch, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *channelPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ch, err := r.client.GetChannel(ctx, discord.Snowflake(state.ChannelID.ValueString()))
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Channel Permissions",
+			"Could not read channel ID "+state.ChannelID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenOverwrites(ch, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update applies the configured overwrites and removes all others.
+func (r *channelPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := discord.Snowflake(plan.ChannelID.ValueString())
+	resp.Diagnostics.Append(r.syncOverwrites(ctx, channelID, plan.Overwrites)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ch, err := r.client.GetChannel(ctx, channelID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Channel Permissions",
+			"Could not read channel ID "+plan.ChannelID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenOverwrites(ch, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the overwrites managed by this resource from the channel.
+func (r *channelPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overwrites []overwriteModel
+	resp.Diagnostics.Append(state.Overwrites.ElementsAs(ctx, &overwrites, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := discord.Snowflake(state.ChannelID.ValueString())
+	for _, ow := range overwrites {
+		err := r.client.DeleteChannelPermission(ctx, channelID, discord.Snowflake(ow.OverwriteID.ValueString()))
+		if err != nil {
+			if discord.IsNotFound(err) {
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Deleting Discord Channel Permissions",
+				"Could not delete permission overwrite "+ow.OverwriteID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ImportState allows importing the overwrites of an existing channel by its ID.
+func (r *channelPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
+
+// syncOverwrites makes the channel's overwrites match the desired set. Overwrites
+// that differ from the live channel are edited and unlisted ones are deleted.
+func (r *channelPermissionsResource) syncOverwrites(ctx context.Context, channelID discord.Snowflake, desiredSet types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired []overwriteModel
+	diags.Append(desiredSet.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ch, err := r.client.GetChannel(ctx, channelID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Discord Channel Permissions",
+			"Could not read channel ID "+channelID.String()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	current := make(map[string]*discord.PermissionOverwrite, len(ch.PermissionOverwrites))
+	for _, ow := range ch.PermissionOverwrites {
+		current[ow.ID.String()] = ow
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, ow := range desired {
+		id := ow.OverwriteID.ValueString()
+		wanted[id] = true
+
+		allow := ow.Allow.ValueString()
+		deny := ow.Deny.ValueString()
+		owType := int(ow.Type.ValueInt64())
+
+		if existing, ok := current[id]; ok && existing.Type == owType && existing.Allow == allow && existing.Deny == deny {
+			continue
+		}
+
+		err := r.client.EditChannelPermissions(ctx, channelID, discord.Snowflake(id), &discord.EditPermissionsParams{
+			Allow: &allow,
+			Deny:  &deny,
+			Type:  owType,
+		})
+		if err != nil {
+			diags.AddError(
+				"Error Setting Discord Channel Permissions",
+				"Could not set permission overwrite "+id+": "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	for id := range current {
+		if wanted[id] {
+			continue
+		}
+		err := r.client.DeleteChannelPermission(ctx, channelID, discord.Snowflake(id))
+		if err != nil && !discord.IsNotFound(err) {
+			diags.AddError(
+				"Error Deleting Discord Channel Permissions",
+				"Could not delete permission overwrite "+id+": "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// flattenOverwrites maps a channel's permission overwrites to the Terraform state model.
+func flattenOverwrites(ch *discord.Channel, state *channelPermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(ch.ID.String())
+	state.ChannelID = types.StringValue(ch.ID.String())
+
+	vals := make([]attr.Value, 0, len(ch.PermissionOverwrites))
+	for _, ow := range ch.PermissionOverwrites {
+		obj, d := types.ObjectValue(overwriteAttrTypes(), map[string]attr.Value{
+			"overwrite_id": types.StringValue(ow.ID.String()),
+			"type":         types.Int64Value(int64(ow.Type)),
+			"allow":        types.StringValue(ow.Allow),
+			"deny":         types.StringValue(ow.Deny),
+		})
+		diags.Append(d...)
+		vals = append(vals, obj)
+	}
+
+	set, d := types.SetValue(types.ObjectType{AttrTypes: overwriteAttrTypes()}, vals)
+	diags.Append(d...)
+	state.Overwrites = set
+	return diags
+}
@@ -0,0 +1,141 @@
+package channel_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelPermissions_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccChannelPermissionsConfig_basic(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_channel_permissions.test", "id", "discord_channel.perms_test", "id"),
+					resource.TestCheckResourceAttr("discord_channel_permissions.test", "overwrites.#", "2"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_channel_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update: remove an overwrite
+			{
+				Config: testAccChannelPermissionsConfig_updated(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_channel_permissions.test", "overwrites.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("discord_channel_permissions.test", "overwrites.*", map[string]string{
+						"type":  "0",
+						"allow": "3072",
+						"deny":  "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChannelPermissions_duplicateOverwrite(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccChannelPermissionsConfig_duplicate(),
+				ExpectError: regexp.MustCompile(`Duplicate Overwrite ID`),
+			},
+		},
+	})
+}
+
+func testAccChannelPermissionsConfig_basic(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "perms_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-perms-test"
+  type     = 0
+}
+
+resource "discord_role" "perms_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-perms-role"
+}
+
+resource "discord_channel_permissions" "test" {
+  channel_id = discord_channel.perms_test.id
+
+  overwrites = [
+    {
+      overwrite_id = %[1]q
+      type         = 0
+      deny         = "1024"
+    },
+    {
+      overwrite_id = discord_role.perms_test.id
+      type         = 0
+      allow        = "3072"
+    },
+  ]
+}
+`, guildID)
+}
+
+func testAccChannelPermissionsConfig_updated(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "perms_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-perms-test"
+  type     = 0
+}
+
+resource "discord_role" "perms_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-perms-role"
+}
+
+resource "discord_channel_permissions" "test" {
+  channel_id = discord_channel.perms_test.id
+
+  overwrites = [
+    {
+      overwrite_id = discord_role.perms_test.id
+      type         = 0
+      allow        = "3072"
+    },
+  ]
+}
+`, guildID)
+}
+
+func testAccChannelPermissionsConfig_duplicate() string {
+	return `
+resource "discord_channel_permissions" "test" {
+  channel_id = "123456789012345678"
+
+  overwrites = [
+    {
+      overwrite_id = "123456789012345678"
+      type         = 0
+      deny         = "1024"
+    },
+    {
+      overwrite_id = "123456789012345678"
+      type         = 0
+      allow        = "3072"
+    },
+  ]
+}
+`
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccChannel_basic(t *testing.T) {
@@ -81,6 +82,47 @@ func TestAccChannel_category(t *testing.T) {
 	})
 }
 
+func TestAccChannel_convertType(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_type(guildID, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_channel.convert", "type", "0"),
+				),
+			},
+			// Text to announcement is converted in place.
+			{
+				Config: testAccChannelConfig_type(guildID, 5),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discord_channel.convert", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_channel.convert", "type", "5"),
+				),
+			},
+			// Other type changes recreate the channel.
+			{
+				Config: testAccChannelConfig_type(guildID, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discord_channel.convert", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_channel.convert", "type", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccChannelConfig_basic(guildID string) string {
 	return fmt.Sprintf(`
 resource "discord_channel" "test" {
@@ -121,3 +163,13 @@ resource "discord_channel" "category" {
 }
 `, guildID)
 }
+
+func testAccChannelConfig_type(guildID string, channelType int) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "convert" {
+  guild_id = %[1]q
+  name     = "tf-acc-convert-test"
+  type     = %[2]d
+}
+`, guildID, channelType)
+}
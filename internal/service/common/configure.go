@@ -10,25 +10,47 @@ import (
 
 // ClientFromProviderData extracts the Discord client from provider data.
 // Returns nil client if provider data is nil (during early provider configuration).
-// Adds an error diagnostic if the type assertion fails.
+// Adds an error diagnostic if the type assertion fails or no bot token is configured.
 func ClientFromProviderData(providerData any, diagnostics *diag.Diagnostics) *discord.Client {
-	if providerData == nil {
+	data := ProviderDataFromConfig(providerData, diagnostics)
+	if data == nil {
 		return nil
 	}
-	data, ok := providerData.(*conns.ProviderData)
-	if !ok {
+	return data.Client
+}
+
+// ProviderDataFromConfig extracts the full ProviderData from provider data.
+// Used by resources that need both client and application ID.
+func ProviderDataFromConfig(providerData any, diagnostics *diag.Diagnostics) *conns.ProviderData {
+	data := providerDataFromConfig(providerData, diagnostics)
+	if data == nil {
+		return nil
+	}
+	if !data.Client.HasToken() {
 		diagnostics.AddError(
-			"Unexpected Provider Data Type",
-			fmt.Sprintf("Expected *conns.ProviderData, got: %T", providerData),
+			"Missing Discord Bot Token",
+			"The provider requires a Discord bot token to authenticate API requests for everything except "+
+				"webhook messages. "+
+				"Set the token in the provider configuration block or via the DISCORD_TOKEN environment variable.",
 		)
 		return nil
 	}
+	return data
+}
+
+// WebhookClientFromProviderData extracts the Discord client from provider
+// data without requiring a bot token. Used by resources that only call
+// webhook routes, which are authenticated by the webhook token.
+func WebhookClientFromProviderData(providerData any, diagnostics *diag.Diagnostics) *discord.Client {
+	data := providerDataFromConfig(providerData, diagnostics)
+	if data == nil {
+		return nil
+	}
 	return data.Client
 }
 
-// ProviderDataFromConfig extracts the full ProviderData from provider data.
-// Used by resources that need both client and application ID.
-func ProviderDataFromConfig(providerData any, diagnostics *diag.Diagnostics) *conns.ProviderData {
+// providerDataFromConfig asserts the type of provider data.
+func providerDataFromConfig(providerData any, diagnostics *diag.Diagnostics) *conns.ProviderData {
 	if providerData == nil {
 		return nil
 	}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// LoadImage resolves an image configured either as a base64 data URI or as a
+// local file path. It returns the data URI to upload and the hex-encoded
+// SHA-256 hash of the image bytes, so the same image yields the same hash
+// whichever way it was supplied.
+func LoadImage(dataURI, filePath string) (string, string, error) {
+	if filePath != "" {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return "", "", fmt.Errorf("could not read image file: %w", err)
+		}
+		uri := "data:" + http.DetectContentType(data) + ";base64," + base64.StdEncoding.EncodeToString(data)
+		return uri, imageHash(data), nil
+	}
+
+	header, payload, ok := strings.Cut(dataURI, ",")
+	if !ok || !strings.HasPrefix(header, "data:") || !strings.HasSuffix(header, ";base64") {
+		return "", "", fmt.Errorf("expected a base64 data URI such as data:image/png;base64,...")
+	}
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", "", fmt.Errorf("could not decode image data URI: %w", err)
+	}
+	return dataURI, imageHash(data), nil
+}
+
+// imageHash returns the hex-encoded SHA-256 hash of the image bytes.
+func imageHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"errors"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PermissionNamesValidator validates that every element of a set attribute is a
+// permission name known to the discord_permission data source.
+func PermissionNamesValidator() validator.Set {
+	return setvalidator.ValueStringsAre(stringvalidator.OneOf(discord.PermissionNames()...))
+}
+
+// PermissionNamesSetFromBits converts a permission bitfield string to a set of
+// permission names. Bits without a known name are left out of the set with a
+// warning, since a permission name set cannot hold them.
+func PermissionNamesSetFromBits(ctx context.Context, bits string) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names, err := discord.PermissionNamesFromBits(bits)
+	var unknown *discord.UnknownPermissionBitsError
+	if errors.As(err, &unknown) {
+		diags.AddWarning("Unknown Permission Bits",
+			"The permission names leave out permissions this provider does not know: "+unknown.Error()+
+				". Manage them with the raw permission bitfield instead of permission names.")
+	} else if err != nil {
+		diags.AddError("Invalid Permission Bitfield", err.Error())
+		return types.SetNull(types.StringType), diags
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, names)
+	diags.Append(d...)
+	return set, diags
+}
+
+// ModifyPermissionPlan keeps a raw permission bitfield attribute and its named
+// permission set attribute in sync in the planned state. When the names are
+// configured the bitfield is computed from them; otherwise the names are
+// derived from the planned bitfield so plans show readable changes.
+func ModifyPermissionPlan(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan, bitsPath, namesPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var configNames types.Set
+	diags.Append(config.GetAttribute(ctx, namesPath, &configNames)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !configNames.IsNull() {
+		if configNames.IsUnknown() {
+			diags.Append(plan.SetAttribute(ctx, bitsPath, types.StringUnknown())...)
+			return diags
+		}
+
+		var names []string
+		diags.Append(configNames.ElementsAs(ctx, &names, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		bits, err := discord.PermissionBitsFromNames(names)
+		if err != nil {
+			diags.AddAttributeError(namesPath, "Invalid Permission Name", err.Error())
+			return diags
+		}
+
+		diags.Append(plan.SetAttribute(ctx, bitsPath, types.StringValue(bits))...)
+		return diags
+	}
+
+	var planBits types.String
+	diags.Append(plan.GetAttribute(ctx, bitsPath, &planBits)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if planBits.IsNull() || planBits.IsUnknown() {
+		diags.Append(plan.SetAttribute(ctx, namesPath, types.SetUnknown(types.StringType))...)
+		return diags
+	}
+
+	names, d := PermissionNamesSetFromBits(ctx, planBits.ValueString())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, namesPath, names)...)
+	return diags
+}
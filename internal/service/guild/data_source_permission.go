@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,61 +18,6 @@ var (
 // permissionDataSource is the data source implementation.
 type permissionDataSource struct{}
 
-// permissionFlag maps a permission name to its bit position.
-type permissionFlag struct {
-	Name string
-	Bit  uint64
-}
-
-// allPermissions lists all Discord permission flags in order.
-var allPermissions = []permissionFlag{
-	{"create_instant_invite", 0x0000000000000001},
-	{"kick_members", 0x0000000000000002},
-	{"ban_members", 0x0000000000000004},
-	{"administrator", 0x0000000000000008},
-	{"manage_channels", 0x0000000000000010},
-	{"manage_guild", 0x0000000000000020},
-	{"add_reactions", 0x0000000000000040},
-	{"view_audit_log", 0x0000000000000080},
-	{"priority_speaker", 0x0000000000000100},
-	{"stream", 0x0000000000000200},
-	{"view_channel", 0x0000000000000400},
-	{"send_messages", 0x0000000000000800},
-	{"send_tts_messages", 0x0000000000001000},
-	{"manage_messages", 0x0000000000002000},
-	{"embed_links", 0x0000000000004000},
-	{"attach_files", 0x0000000000008000},
-	{"read_message_history", 0x0000000000010000},
-	{"mention_everyone", 0x0000000000020000},
-	{"use_external_emojis", 0x0000000000040000},
-	{"view_guild_insights", 0x0000000000080000},
-	{"connect", 0x0000000000100000},
-	{"speak", 0x0000000000200000},
-	{"mute_members", 0x0000000000400000},
-	{"deafen_members", 0x0000000000800000},
-	{"move_members", 0x0000000001000000},
-	{"use_vad", 0x0000000002000000},
-	{"change_nickname", 0x0000000004000000},
-	{"manage_nicknames", 0x0000000008000000},
-	{"manage_roles", 0x0000000010000000},
-	{"manage_webhooks", 0x0000000020000000},
-	{"manage_guild_expressions", 0x0000000040000000},
-	{"use_application_commands", 0x0000000080000000},
-	{"request_to_speak", 0x0000000100000000},
-	{"manage_events", 0x0000000200000000},
-	{"manage_threads", 0x0000000400000000},
-	{"create_public_threads", 0x0000000800000000},
-	{"create_private_threads", 0x0000001000000000},
-	{"use_external_stickers", 0x0000002000000000},
-	{"send_messages_in_threads", 0x0000004000000000},
-	{"use_embedded_activities", 0x0000008000000000},
-	{"moderate_members", 0x0000010000000000},
-	{"view_creator_monetization_analytics", 0x0000020000000000},
-	{"use_soundboard", 0x0000040000000000},
-	{"use_external_sounds", 0x0000200000000000},
-	{"send_voice_messages", 0x0000400000000000},
-}
-
 // permissionDataSourceModel maps the data source schema data.
 type permissionDataSourceModel struct {
 	CreateInstantInvite              types.Bool   `tfsdk:"create_instant_invite"`
@@ -117,8 +63,15 @@ type permissionDataSourceModel struct {
 	ModerateMembers                  types.Bool   `tfsdk:"moderate_members"`
 	ViewCreatorMonetizationAnalytics types.Bool   `tfsdk:"view_creator_monetization_analytics"`
 	UseSoundboard                    types.Bool   `tfsdk:"use_soundboard"`
+	CreateGuildExpressions           types.Bool   `tfsdk:"create_guild_expressions"`
+	CreateEvents                     types.Bool   `tfsdk:"create_events"`
 	UseExternalSounds                types.Bool   `tfsdk:"use_external_sounds"`
 	SendVoiceMessages                types.Bool   `tfsdk:"send_voice_messages"`
+	SetVoiceChannelStatus            types.Bool   `tfsdk:"set_voice_channel_status"`
+	SendPolls                        types.Bool   `tfsdk:"send_polls"`
+	UseExternalApps                  types.Bool   `tfsdk:"use_external_apps"`
+	PinMessages                      types.Bool   `tfsdk:"pin_messages"`
+	BypassSlowmode                   types.Bool   `tfsdk:"bypass_slowmode"`
 	AllowBits                        types.String `tfsdk:"allow_bits"`
 	DenyBits                         types.String `tfsdk:"deny_bits"`
 }
@@ -312,6 +265,14 @@ func (d *permissionDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: "Allows for using soundboard in a voice channel.",
 				Optional:    true,
 			},
+			"create_guild_expressions": schema.BoolAttribute{
+				Description: "Allows for creating emojis, stickers, and soundboard sounds, and editing and deleting those created by the current user.",
+				Optional:    true,
+			},
+			"create_events": schema.BoolAttribute{
+				Description: "Allows for creating scheduled events, and editing and deleting those created by the current user.",
+				Optional:    true,
+			},
 			"use_external_sounds": schema.BoolAttribute{
 				Description: "Allows the usage of custom soundboard sounds from other servers.",
 				Optional:    true,
@@ -320,6 +281,26 @@ func (d *permissionDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: "Allows sending voice messages.",
 				Optional:    true,
 			},
+			"set_voice_channel_status": schema.BoolAttribute{
+				Description: "Allows setting the status of a voice channel.",
+				Optional:    true,
+			},
+			"send_polls": schema.BoolAttribute{
+				Description: "Allows sending polls.",
+				Optional:    true,
+			},
+			"use_external_apps": schema.BoolAttribute{
+				Description: "Allows user-installed apps to send public responses.",
+				Optional:    true,
+			},
+			"pin_messages": schema.BoolAttribute{
+				Description: "Allows pinning and unpinning messages.",
+				Optional:    true,
+			},
+			"bypass_slowmode": schema.BoolAttribute{
+				Description: "Allows bypassing slowmode restrictions.",
+				Optional:    true,
+			},
 			"allow_bits": schema.StringAttribute{
 				Description: "The computed allow permission integer as a string.",
 				Computed:    true,
@@ -421,10 +402,24 @@ func getBoolField(m *permissionDataSourceModel, name string) types.Bool {
 		return m.ViewCreatorMonetizationAnalytics
 	case "use_soundboard":
 		return m.UseSoundboard
+	case "create_guild_expressions":
+		return m.CreateGuildExpressions
+	case "create_events":
+		return m.CreateEvents
 	case "use_external_sounds":
 		return m.UseExternalSounds
 	case "send_voice_messages":
 		return m.SendVoiceMessages
+	case "set_voice_channel_status":
+		return m.SetVoiceChannelStatus
+	case "send_polls":
+		return m.SendPolls
+	case "use_external_apps":
+		return m.UseExternalApps
+	case "pin_messages":
+		return m.PinMessages
+	case "bypass_slowmode":
+		return m.BypassSlowmode
 	default:
 		return types.BoolNull()
 	}
@@ -441,7 +436,7 @@ func (d *permissionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	var allowBits uint64
 	var denyBits uint64
 
-	for _, perm := range allPermissions {
+	for _, perm := range discord.Permissions {
 		field := getBoolField(&config, perm.Name)
 		if field.IsNull() || field.IsUnknown() {
 			continue
@@ -0,0 +1,213 @@
+package member
+
+import (
+	"context"
+	"time"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &guildMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &guildMembersDataSource{}
+)
+
+// guildMembersDataSource is the data source implementation.
+type guildMembersDataSource struct {
+	client *discord.Client
+}
+
+// guildMembersDataSourceModel maps the data source schema data.
+type guildMembersDataSourceModel struct {
+	GuildID types.String `tfsdk:"guild_id"`
+	Query   types.String `tfsdk:"query"`
+	RoleID  types.String `tfsdk:"role_id"`
+	Bot     types.Bool   `tfsdk:"bot"`
+	Members types.List   `tfsdk:"members"`
+}
+
+// NewGuildMembersDataSource returns a new guild members data source.
+func NewGuildMembersDataSource() datasource.DataSource {
+	return &guildMembersDataSource{}
+}
+
+// guildMemberAttrTypes returns the attr.Type map for a guild member object.
+func guildMemberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"user_id":     types.StringType,
+		"username":    types.StringType,
+		"global_name": types.StringType,
+		"bot":         types.BoolType,
+		"nick":        types.StringType,
+		"roles":       types.SetType{ElemType: types.StringType},
+		"joined_at":   types.StringType,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *guildMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guild_members"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *guildMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the data source.
+func (d *guildMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the members of a Discord guild, optionally searched by name and " +
+			"filtered by role or bot flag. Listing every member requires the privileged GUILD_MEMBERS intent.",
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild to list members for.",
+				Required:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Only return members whose username or nickname starts with this string. Searches " +
+					"return at most 1000 members.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "Only return members that hold this role.",
+				Optional:    true,
+			},
+			"bot": schema.BoolAttribute{
+				Description: "Only return bot users (true) or human users (false).",
+				Optional:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The matching members.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The user's username.",
+							Computed:    true,
+						},
+						"global_name": schema.StringAttribute{
+							Description: "The user's display name, if set.",
+							Computed:    true,
+						},
+						"bot": schema.BoolAttribute{
+							Description: "Whether the user is a bot.",
+							Computed:    true,
+						},
+						"nick": schema.StringAttribute{
+							Description: "The member's nickname in the guild, if set.",
+							Computed:    true,
+						},
+						"roles": schema.SetAttribute{
+							Description: "The IDs of the roles the member holds.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"joined_at": schema.StringAttribute{
+							Description: "When the member joined the guild, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *guildMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config guildMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := discord.Snowflake(config.GuildID.ValueString())
+
+	var members []*discord.Member
+	var err error
+	if !config.Query.IsNull() {
+		members, err = d.client.SearchGuildMembers(ctx, guildID, config.Query.ValueString(), discord.MaxMemberSearchLimit)
+	} else {
+		members, err = d.client.ListGuildMembers(ctx, guildID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Guild Members",
+			"Could not read members of guild "+config.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	memberObjects := make([]attr.Value, 0, len(members))
+	for _, member := range members {
+		if member.User == nil {
+			continue
+		}
+		if !config.RoleID.IsNull() && !hasRole(member, config.RoleID.ValueString()) {
+			continue
+		}
+		if !config.Bot.IsNull() && member.User.Bot != config.Bot.ValueBool() {
+			continue
+		}
+
+		obj, diags := flattenGuildMember(ctx, member)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		memberObjects = append(memberObjects, obj)
+	}
+
+	membersList, diags := types.ListValue(types.ObjectType{AttrTypes: guildMemberAttrTypes()}, memberObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Members = membersList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// flattenGuildMember converts a Discord member to a guild member object value.
+func flattenGuildMember(ctx context.Context, member *discord.Member) (types.Object, diag.Diagnostics) {
+	roles, diags := memberRoleSet(ctx, member)
+	if diags.HasError() {
+		return types.ObjectNull(guildMemberAttrTypes()), diags
+	}
+
+	globalName := types.StringNull()
+	if member.User.GlobalName != nil {
+		globalName = types.StringValue(*member.User.GlobalName)
+	}
+	nick := types.StringNull()
+	if member.Nick != nil {
+		nick = types.StringValue(*member.Nick)
+	}
+
+	return types.ObjectValue(guildMemberAttrTypes(), map[string]attr.Value{
+		"user_id":     types.StringValue(member.User.ID.String()),
+		"username":    types.StringValue(member.User.Username),
+		"global_name": globalName,
+		"bot":         types.BoolValue(member.User.Bot),
+		"nick":        nick,
+		"roles":       roles,
+		"joined_at":   types.StringValue(member.JoinedAt.UTC().Format(time.RFC3339)),
+	})
+}
@@ -0,0 +1,58 @@
+package member_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGuildMembersDataSource_roleFilter(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuildMembersDataSourceConfig_roleFilter(guildID, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discord_guild_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.discord_guild_members.test", "members.0.user_id", userID),
+					resource.TestCheckResourceAttrSet("data.discord_guild_members.test", "members.0.username"),
+					resource.TestCheckResourceAttrSet("data.discord_guild_members.test", "members.0.joined_at"),
+					resource.TestCheckResourceAttrSet("data.discord_guild_members.bots", "members.0.user_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuildMembersDataSourceConfig_roleFilter(guildID, userID string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "guild_members_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-guild-members"
+}
+
+resource "discord_member_role" "test" {
+  guild_id = %[1]q
+  user_id  = %[2]q
+  role_id  = discord_role.guild_members_test.id
+}
+
+data "discord_guild_members" "test" {
+  guild_id = %[1]q
+  role_id  = discord_member_role.test.role_id
+}
+
+# The provider's own bot is always a member.
+data "discord_guild_members" "bots" {
+  guild_id = %[1]q
+  bot      = true
+}
+`, guildID, userID)
+}
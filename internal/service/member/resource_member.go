@@ -0,0 +1,434 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &memberResource{}
+	_ resource.ResourceWithConfigure   = &memberResource{}
+	_ resource.ResourceWithImportState = &memberResource{}
+)
+
+// memberResource is the resource implementation.
+type memberResource struct {
+	client *discord.Client
+}
+
+// memberResourceModel maps the resource schema to a Go struct.
+type memberResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	GuildID              types.String `tfsdk:"guild_id"`
+	UserID               types.String `tfsdk:"user_id"`
+	Nick                 types.String `tfsdk:"nick"`
+	Mute                 types.Bool   `tfsdk:"mute"`
+	Deaf                 types.Bool   `tfsdk:"deaf"`
+	TimeoutUntil         types.String `tfsdk:"timeout_until"`
+	BypassesVerification types.Bool   `tfsdk:"bypasses_verification"`
+	Flags                types.Int64  `tfsdk:"flags"`
+}
+
+// NewMemberResource returns a new member resource.
+func NewMemberResource() resource.Resource {
+	return &memberResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *memberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *memberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// timeoutUntilValidator validates that timeout_until is an RFC3339 timestamp
+// no further in the future than Discord's maximum timeout.
+type timeoutUntilValidator struct{}
+
+func (v timeoutUntilValidator) Description(_ context.Context) string {
+	return "timeout_until must be an RFC3339 timestamp at most 28 days in the future"
+}
+
+func (v timeoutUntilValidator) MarkdownDescription(_ context.Context) string {
+	return "timeout_until must be an RFC3339 timestamp at most 28 days in the future"
+}
+
+func (v timeoutUntilValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	until, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("timeout_until must be an RFC3339 timestamp (e.g. 2025-01-02T15:04:05Z): %s", err),
+		)
+		return
+	}
+	if until.After(time.Now().Add(discord.MaxMemberTimeout)) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Timeout Too Long",
+			"Discord does not allow timeouts more than 28 days in the future, got "+req.ConfigValue.ValueString()+".",
+		)
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a Discord guild member: nickname, server mute and deafen, timeout and " +
+			"member flags. The user must already be in the guild. Destroying the resource clears these settings " +
+			"but does not remove the member from the guild. A server mute or deafen is left in place, with a " +
+			"warning, when the member is not connected to voice.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The composite ID (guild_id/user_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nick": schema.StringAttribute{
+				Description: "The member's nickname in the guild (1-32 characters). Leaving this unset clears the nickname.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"mute": schema.BoolAttribute{
+				Description: "Whether the member is server muted in voice channels. Discord only accepts changes " +
+					"while the member is connected to voice. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"deaf": schema.BoolAttribute{
+				Description: "Whether the member is server deafened in voice channels. Discord only accepts changes " +
+					"while the member is connected to voice. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"timeout_until": schema.StringAttribute{
+				Description: "When the member's timeout ends, as an RFC3339 timestamp at most 28 days in the future. " +
+					"An expired timeout is not treated as drift.",
+				Optional: true,
+				Validators: []validator.String{
+					timeoutUntilValidator{},
+				},
+			},
+			"bypasses_verification": schema.BoolAttribute{
+				Description: "Whether the member is exempt from the guild's verification requirements (the " +
+					"BYPASSES_VERIFICATION member flag). Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"flags": schema.Int64Attribute{
+				Description: "The member's guild member flags as a bit set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create applies the configured settings to an existing guild member.
+func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan memberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := discord.Snowflake(plan.GuildID.ValueString())
+	userID := discord.Snowflake(plan.UserID.ValueString())
+
+	member, err := r.client.GetGuildMember(ctx, guildID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Member",
+			"Could not read member "+plan.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var current memberResourceModel
+	mapMemberToState(member, &current)
+
+	params, err := buildMemberParams(plan, current)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_until"), "Invalid Timestamp", err.Error())
+		return
+	}
+
+	member, err = r.client.ModifyGuildMember(ctx, guildID, userID, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Discord Member",
+			"Could not update member "+plan.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.GuildID.ValueString() + "/" + plan.UserID.ValueString())
+	mapMemberToState(member, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state memberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetGuildMember(ctx,
+		discord.Snowflake(state.GuildID.ValueString()),
+		discord.Snowflake(state.UserID.ValueString()),
+	)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Member",
+			"Could not read member "+state.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapMemberToState(member, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update modifies the member settings.
+func (r *memberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan memberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state memberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := buildMemberParams(plan, state)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_until"), "Invalid Timestamp", err.Error())
+		return
+	}
+
+	member, err := r.client.ModifyGuildMember(ctx,
+		discord.Snowflake(state.GuildID.ValueString()),
+		discord.Snowflake(state.UserID.ValueString()),
+		params,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Discord Member",
+			"Could not update member "+state.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	mapMemberToState(member, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete clears the nickname, voice state, timeout and flags that this
+// resource manages. The member stays in the guild. Mute and deafen are only
+// reset when they are set, and are left as they are when the member is not
+// connected to voice, since Discord rejects the change then.
+func (r *memberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state memberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cleared := state
+	cleared.Nick = types.StringNull()
+	cleared.Mute = types.BoolValue(false)
+	cleared.Deaf = types.BoolValue(false)
+	cleared.TimeoutUntil = types.StringNull()
+	cleared.BypassesVerification = types.BoolValue(false)
+
+	params, err := buildMemberParams(cleared, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Resetting Discord Member", err.Error())
+		return
+	}
+	if *params == (discord.ModifyMemberParams{}) {
+		return
+	}
+
+	guildID := discord.Snowflake(state.GuildID.ValueString())
+	userID := discord.Snowflake(state.UserID.ValueString())
+	_, err = r.client.ModifyGuildMember(ctx, guildID, userID, params)
+	if discord.HasErrorCode(err, discord.ErrCodeTargetNotInVoice) {
+		resp.Diagnostics.AddWarning(
+			"Member Voice State Not Reset",
+			"Member "+state.UserID.ValueString()+" is not connected to voice, so Discord does not allow "+
+				"removing their server mute or deafen. The member's other settings were reset.",
+		)
+		params.Mute, params.Deaf = nil, nil
+		err = nil
+		if *params != (discord.ModifyMemberParams{}) {
+			_, err = r.client.ModifyGuildMember(ctx, guildID, userID, params)
+		}
+	}
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Resetting Discord Member",
+			"Could not reset member "+state.UserID.ValueString()+": "+err.Error(),
+		)
+	}
+}
+
+// ImportState allows importing by guild_id/user_id.
+func (r *memberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'guild_id/user_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+// buildMemberParams builds the modify parameters for the settings that differ
+// between the desired and current values. Mute and deafen are only sent when
+// they change, because Discord rejects them for members not in voice.
+func buildMemberParams(desired, current memberResourceModel) (*discord.ModifyMemberParams, error) {
+	params := &discord.ModifyMemberParams{}
+
+	if !desired.Nick.Equal(current.Nick) {
+		if desired.Nick.IsNull() {
+			params.Nick = &discord.NullString{Null: true}
+		} else {
+			params.Nick = &discord.NullString{Value: desired.Nick.ValueString()}
+		}
+	}
+
+	if !desired.Mute.IsUnknown() && desired.Mute.ValueBool() != current.Mute.ValueBool() {
+		v := desired.Mute.ValueBool()
+		params.Mute = &v
+	}
+	if !desired.Deaf.IsUnknown() && desired.Deaf.ValueBool() != current.Deaf.ValueBool() {
+		v := desired.Deaf.ValueBool()
+		params.Deaf = &v
+	}
+
+	if !desired.TimeoutUntil.Equal(current.TimeoutUntil) {
+		if desired.TimeoutUntil.IsNull() {
+			if timeoutActive(current.TimeoutUntil) {
+				params.CommunicationDisabledUntil = &discord.NullTime{Null: true}
+			}
+		} else {
+			until, err := time.Parse(time.RFC3339, desired.TimeoutUntil.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("could not parse timeout_until: %w", err)
+			}
+			if until.After(time.Now()) {
+				params.CommunicationDisabledUntil = &discord.NullTime{Value: until}
+			} else if timeoutActive(current.TimeoutUntil) {
+				params.CommunicationDisabledUntil = &discord.NullTime{Null: true}
+			}
+		}
+	}
+
+	if !desired.BypassesVerification.IsUnknown() && desired.BypassesVerification.ValueBool() != current.BypassesVerification.ValueBool() {
+		flags := int(current.Flags.ValueInt64()) &^ discord.MemberFlagBypassesVerification
+		if desired.BypassesVerification.ValueBool() {
+			flags |= discord.MemberFlagBypassesVerification
+		}
+		params.Flags = &flags
+	}
+
+	return params, nil
+}
+
+// timeoutActive reports whether the timestamp is set and still in the future.
+func timeoutActive(value types.String) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, value.ValueString())
+	return err == nil && until.After(time.Now())
+}
+
+// mapMemberToState maps a Discord Member API response to the Terraform state
+// model. The timestamp already in state is kept when it names the same
+// instant, and an expired timeout is kept rather than reported as drift.
+func mapMemberToState(member *discord.Member, state *memberResourceModel) {
+	if member.Nick != nil && *member.Nick != "" {
+		state.Nick = types.StringValue(*member.Nick)
+	} else {
+		state.Nick = types.StringNull()
+	}
+	state.Mute = types.BoolValue(member.Mute)
+	state.Deaf = types.BoolValue(member.Deaf)
+	state.Flags = types.Int64Value(int64(member.Flags))
+	state.BypassesVerification = types.BoolValue(member.Flags&discord.MemberFlagBypassesVerification != 0)
+
+	until := member.CommunicationDisabledUntil
+	switch {
+	case until != nil && until.After(time.Now()):
+		if !state.TimeoutUntil.IsNull() && !state.TimeoutUntil.IsUnknown() {
+			if prior, err := time.Parse(time.RFC3339, state.TimeoutUntil.ValueString()); err == nil && prior.Equal(*until) {
+				return
+			}
+		}
+		state.TimeoutUntil = types.StringValue(until.UTC().Format(time.RFC3339))
+	case state.TimeoutUntil.IsUnknown() || timeoutActive(state.TimeoutUntil):
+		state.TimeoutUntil = types.StringNull()
+	}
+}
@@ -0,0 +1,62 @@
+package member
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ---------- TestBuildMemberParams_VoiceState ----------
+
+func TestBuildMemberParams_VoiceState(t *testing.T) {
+	t.Parallel()
+
+	member := func(nick string, mute, deaf bool) memberResourceModel {
+		m := memberResourceModel{
+			Nick:                 types.StringNull(),
+			Mute:                 types.BoolValue(mute),
+			Deaf:                 types.BoolValue(deaf),
+			TimeoutUntil:         types.StringNull(),
+			BypassesVerification: types.BoolValue(false),
+			Flags:                types.Int64Value(0),
+		}
+		if nick != "" {
+			m.Nick = types.StringValue(nick)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name         string
+		desired      memberResourceModel
+		current      memberResourceModel
+		expectMute   bool
+		expectDeaf   bool
+		expectChange bool
+	}{
+		{name: "reset nick only", desired: member("", false, false), current: member("Nick", false, false), expectChange: true},
+		{name: "reset mute", desired: member("", false, false), current: member("", true, false), expectMute: true, expectChange: true},
+		{name: "reset deaf", desired: member("", false, false), current: member("", false, true), expectDeaf: true, expectChange: true},
+		{name: "nothing set", desired: member("", false, false), current: member("", false, false)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			params, err := buildMemberParams(tc.desired, tc.current)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (params.Mute != nil) != tc.expectMute {
+				t.Errorf("expected mute sent %t, got %v", tc.expectMute, params.Mute)
+			}
+			if (params.Deaf != nil) != tc.expectDeaf {
+				t.Errorf("expected deaf sent %t, got %v", tc.expectDeaf, params.Deaf)
+			}
+			if (params.Nick != nil || params.Mute != nil || params.Deaf != nil) != tc.expectChange {
+				t.Errorf("expected change %t, got %+v", tc.expectChange, params)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &memberRoleResource{}
+	_ resource.ResourceWithConfigure   = &memberRoleResource{}
+	_ resource.ResourceWithImportState = &memberRoleResource{}
+)
+
+// memberRoleResource is the resource implementation.
+type memberRoleResource struct {
+	client *discord.Client
+}
+
+// memberRoleResourceModel maps the resource schema to a Go struct.
+type memberRoleResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	GuildID types.String `tfsdk:"guild_id"`
+	UserID  types.String `tfsdk:"user_id"`
+	RoleID  types.String `tfsdk:"role_id"`
+}
+
+// NewMemberRoleResource returns a new member role resource.
+func NewMemberRoleResource() resource.Resource {
+	return &memberRoleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *memberRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_role"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *memberRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the resource.
+func (r *memberRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a single role to a Discord guild member. Unlike discord_member_roles, this resource " +
+			"leaves the member's other roles untouched, so it can coexist with roles granted by bots, boosts or " +
+			"onboarding.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The composite ID (guild_id/user_id/role_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role to assign.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the role to the member.
+func (r *memberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan memberRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddGuildMemberRole(ctx,
+		discord.Snowflake(plan.GuildID.ValueString()),
+		discord.Snowflake(plan.UserID.ValueString()),
+		discord.Snowflake(plan.RoleID.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding Discord Member Role",
+			"Could not add role "+plan.RoleID.ValueString()+" to member "+plan.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.GuildID.ValueString() + "/" + plan.UserID.ValueString() + "/" + plan.RoleID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *memberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state memberRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetGuildMember(ctx,
+		discord.Snowflake(state.GuildID.ValueString()),
+		discord.Snowflake(state.UserID.ValueString()),
+	)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Member Role",
+			"Could not read member "+state.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The role was removed outside of Terraform.
+	if !hasRole(member, state.RoleID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is not supported; all attributes require replacement.
+func (r *memberRoleResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"discord_member_role does not support in-place updates. All changes require replacement.",
+	)
+}
+
+// Delete removes the role from the member.
+func (r *memberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state memberRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveGuildMemberRole(ctx,
+		discord.Snowflake(state.GuildID.ValueString()),
+		discord.Snowflake(state.UserID.ValueString()),
+		discord.Snowflake(state.RoleID.ValueString()),
+	)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Discord Member Role",
+			"Could not remove role "+state.RoleID.ValueString()+" from member "+state.UserID.ValueString()+": "+err.Error(),
+		)
+	}
+}
+
+// ImportState allows importing by guild_id/user_id/role_id.
+func (r *memberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'guild_id/user_id/role_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), parts[2])...)
+}
+
+// hasRole reports whether the member has the given role.
+func hasRole(member *discord.Member, roleID string) bool {
+	for _, id := range member.Roles {
+		if id.String() == roleID {
+			return true
+		}
+	}
+	return false
+}
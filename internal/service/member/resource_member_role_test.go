@@ -0,0 +1,64 @@
+package member_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMemberRole_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberRoleConfig_basic(guildID, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_member_role.first", "guild_id", guildID),
+					resource.TestCheckResourceAttr("discord_member_role.first", "user_id", userID),
+					resource.TestCheckResourceAttrPair("discord_member_role.first", "role_id", "discord_role.member_role_first", "id"),
+					resource.TestCheckResourceAttrPair("discord_member_role.second", "role_id", "discord_role.member_role_second", "id"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_member_role.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMemberRoleConfig_basic(guildID, userID string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "member_role_first" {
+  guild_id = %[1]q
+  name     = "tf-acc-member-role-first"
+}
+
+resource "discord_role" "member_role_second" {
+  guild_id = %[1]q
+  name     = "tf-acc-member-role-second"
+}
+
+resource "discord_member_role" "first" {
+  guild_id = %[1]q
+  user_id  = %[2]q
+  role_id  = discord_role.member_role_first.id
+}
+
+resource "discord_member_role" "second" {
+  guild_id = %[1]q
+  user_id  = %[2]q
+  role_id  = discord_role.member_role_second.id
+}
+`, guildID, userID)
+}
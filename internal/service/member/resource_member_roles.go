@@ -7,12 +7,17 @@ import (
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
 	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -30,12 +35,22 @@ type memberRolesResource struct {
 
 // memberRolesResourceModel maps the resource schema to a Go struct.
 type memberRolesResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	GuildID types.String `tfsdk:"guild_id"`
-	UserID  types.String `tfsdk:"user_id"`
-	Roles   types.Set    `tfsdk:"roles"`
+	ID               types.String `tfsdk:"id"`
+	GuildID          types.String `tfsdk:"guild_id"`
+	UserID           types.String `tfsdk:"user_id"`
+	Roles            types.Set    `tfsdk:"roles"`
+	ManagedRolesOnly types.Bool   `tfsdk:"managed_roles_only"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
+	OriginalRoles    types.Set    `tfsdk:"original_roles"`
 }
 
+// Values for on_destroy.
+const (
+	onDestroyRestore       = "restore"
+	onDestroyRemoveManaged = "remove_managed"
+	onDestroyClear         = "clear"
+)
+
 // NewMemberRolesResource returns a new member roles resource.
 func NewMemberRolesResource() resource.Resource {
 	return &memberRolesResource{}
@@ -54,7 +69,8 @@ func (r *memberRolesResource) Configure(_ context.Context, req resource.Configur
 // Schema defines the schema for the resource.
 func (r *memberRolesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages the set of roles for a Discord guild member.",
+		Description: "Manages the set of roles for a Discord guild member. By default the set is authoritative and " +
+			"any role not listed is removed; set managed_roles_only to leave unlisted roles alone.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The composite ID (guild_id/user_id).",
@@ -82,6 +98,37 @@ func (r *memberRolesResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Required:    true,
 				ElementType: types.StringType,
 			},
+			"managed_roles_only": schema.BoolAttribute{
+				Description: "When true, only the roles listed in roles are added or removed, and roles granted " +
+					"by other sources such as bots, boosts or onboarding are left untouched. Turning it on only adds " +
+					"the listed roles and removes none. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What happens to the member's roles when this resource is destroyed: restore puts back " +
+					"the roles recorded in original_roles, remove_managed removes only the roles listed in roles, " +
+					"and clear removes every role. Defaults to restore. Resources created before original_roles " +
+					"was recorded have nothing to restore, so restore leaves the member's roles unchanged and " +
+					"reports a warning.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(onDestroyRestore),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyRestore, onDestroyRemoveManaged, onDestroyClear),
+				},
+			},
+			"original_roles": schema.SetAttribute{
+				Description: "The role IDs the member had before this resource was created, or when it was " +
+					"imported. Used to restore the member's roles on destroy. Null for resources created " +
+					"before this attribute was added.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -97,21 +144,32 @@ func (r *memberRolesResource) Create(ctx context.Context, req resource.CreateReq
 	guildID := discord.Snowflake(plan.GuildID.ValueString())
 	userID := discord.Snowflake(plan.UserID.ValueString())
 
-	roleIDs, diags := extractRoleIDs(ctx, plan.Roles)
+	roleIDs, diags := extractIDs(ctx, plan.Roles)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set all roles at once using ModifyGuildMember.
-	snowflakes := make([]discord.Snowflake, len(roleIDs))
-	for i, id := range roleIDs {
-		snowflakes[i] = discord.Snowflake(id)
+	// Record the member's roles before changing them so they can be restored.
+	member, err := r.client.GetGuildMember(ctx, guildID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Member",
+			"Could not read member "+plan.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.OriginalRoles, diags = memberRoleSet(ctx, member)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	_, err := r.client.ModifyGuildMember(ctx, guildID, userID, &discord.ModifyMemberParams{
-		Roles: snowflakes,
-	})
+	if plan.ManagedRolesOnly.ValueBool() {
+		err = r.changeRoles(ctx, guildID, userID, roleIDs, nil)
+	} else {
+		err = r.setRoles(ctx, guildID, userID, roleIDs)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Setting Discord Member Roles",
@@ -149,10 +207,34 @@ func (r *memberRolesResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// Convert the member roles to a set.
-	roleStrings := make([]string, len(member.Roles))
-	for i, r := range member.Roles {
-		roleStrings[i] = r.String()
+	if state.ManagedRolesOnly.IsNull() {
+		state.ManagedRolesOnly = types.BoolValue(false)
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyRestore)
+	}
+
+	// Convert the member roles to a set. In managed_roles_only mode only the
+	// roles Terraform manages are tracked, so other roles never show as drift.
+	var managed map[string]bool
+	if state.ManagedRolesOnly.ValueBool() {
+		stateRoleIDs, diags := extractIDs(ctx, state.Roles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		managed = make(map[string]bool, len(stateRoleIDs))
+		for _, id := range stateRoleIDs {
+			managed[id] = true
+		}
+	}
+
+	roleStrings := make([]string, 0, len(member.Roles))
+	for _, r := range member.Roles {
+		if managed != nil && !managed[r.String()] {
+			continue
+		}
+		roleStrings = append(roleStrings, r.String())
 	}
 
 	rolesSet, diags := types.SetValueFrom(ctx, types.StringType, roleStrings)
@@ -182,20 +264,30 @@ func (r *memberRolesResource) Update(ctx context.Context, req resource.UpdateReq
 	guildID := discord.Snowflake(state.GuildID.ValueString())
 	userID := discord.Snowflake(state.UserID.ValueString())
 
-	roleIDs, diags := extractRoleIDs(ctx, plan.Roles)
+	roleIDs, diags := extractIDs(ctx, plan.Roles)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	snowflakes := make([]discord.Snowflake, len(roleIDs))
-	for i, id := range roleIDs {
-		snowflakes[i] = discord.Snowflake(id)
+	var err error
+	if plan.ManagedRolesOnly.ValueBool() {
+		stateRoleIDs, diags := extractIDs(ctx, state.Roles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		add, remove := diffIDs(stateRoleIDs, roleIDs)
+		if !state.ManagedRolesOnly.ValueBool() {
+			// Switching to managed_roles_only: state holds every role the
+			// member has, so removing what is not listed would strip roles
+			// granted by other sources.
+			remove = nil
+		}
+		err = r.changeRoles(ctx, guildID, userID, add, remove)
+	} else {
+		err = r.setRoles(ctx, guildID, userID, roleIDs)
 	}
-
-	_, err := r.client.ModifyGuildMember(ctx, guildID, userID, &discord.ModifyMemberParams{
-		Roles: snowflakes,
-	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Discord Member Roles",
@@ -205,11 +297,11 @@ func (r *memberRolesResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	plan.ID = state.ID
+	plan.OriginalRoles = state.OriginalRoles
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// Delete removes all managed roles from the member. This sets the member's
-// roles to an empty list, effectively removing all roles.
+// Delete handles the member's roles according to on_destroy.
 func (r *memberRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state memberRolesResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -220,9 +312,37 @@ func (r *memberRolesResource) Delete(ctx context.Context, req resource.DeleteReq
 	guildID := discord.Snowflake(state.GuildID.ValueString())
 	userID := discord.Snowflake(state.UserID.ValueString())
 
-	_, err := r.client.ModifyGuildMember(ctx, guildID, userID, &discord.ModifyMemberParams{
-		Roles: []discord.Snowflake{},
-	})
+	roleIDs, diags := extractIDs(ctx, state.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	switch state.OnDestroy.ValueString() {
+	case onDestroyClear:
+		err = r.setRoles(ctx, guildID, userID, nil)
+	case onDestroyRemoveManaged:
+		err = r.changeRoles(ctx, guildID, userID, nil, roleIDs)
+	default:
+		// State written before original_roles existed has nothing to restore.
+		// Those versions left the member's roles alone on destroy, so do the
+		// same rather than guess.
+		if state.OriginalRoles.IsNull() {
+			resp.Diagnostics.AddWarning(
+				"Member Roles Not Restored",
+				"No original roles were recorded for member "+state.UserID.ValueString()+", since the resource "+
+					"was created by an earlier version of the provider, so the member's roles were left unchanged.",
+			)
+			return
+		}
+		originalRoleIDs, diags := extractIDs(ctx, state.OriginalRoles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err = r.restoreRoles(ctx, guildID, userID, state.ManagedRolesOnly.ValueBool(), originalRoleIDs, roleIDs)
+	}
 	if err != nil {
 		if discord.IsNotFound(err) {
 			return
@@ -234,7 +354,9 @@ func (r *memberRolesResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
-// ImportState allows importing by guild_id/user_id.
+// ImportState allows importing by guild_id/user_id. Imported resources have
+// no record of earlier roles, so the roles at import time are recorded as
+// original_roles and restored on destroy.
 func (r *memberRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -245,14 +367,119 @@ func (r *memberRolesResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
+	member, err := r.client.GetGuildMember(ctx, discord.Snowflake(parts[0]), discord.Snowflake(parts[1]))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Discord Member Roles",
+			"Could not read member "+parts[1]+": "+err.Error(),
+		)
+		return
+	}
+	originalRoles, diags := memberRoleSet(ctx, member)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("original_roles"), originalRoles)...)
+}
+
+// setRoles replaces the member's roles with the given role IDs.
+func (r *memberRolesResource) setRoles(ctx context.Context, guildID, userID discord.Snowflake, roleIDs []string) error {
+	snowflakes := make([]discord.Snowflake, len(roleIDs))
+	for i, id := range roleIDs {
+		snowflakes[i] = discord.Snowflake(id)
+	}
+
+	_, err := r.client.ModifyGuildMember(ctx, guildID, userID, &discord.ModifyMemberParams{
+		Roles: &snowflakes,
+	})
+	return err
+}
+
+// restoreRoles puts back the member's original roles. In managed_roles_only
+// mode only the roles Terraform added are removed; otherwise the whole role
+// list is replaced, skipping original roles that have since been deleted.
+func (r *memberRolesResource) restoreRoles(ctx context.Context, guildID, userID discord.Snowflake, managedOnly bool, originalRoleIDs, roleIDs []string) error {
+	if managedOnly {
+		added, _ := diffIDs(originalRoleIDs, roleIDs)
+		return r.changeRoles(ctx, guildID, userID, nil, added)
+	}
+
+	roles, err := r.client.GetGuildRoles(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("reading guild roles: %w", err)
+	}
+	exists := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		exists[role.ID.String()] = true
+	}
+
+	restore := make([]string, 0, len(originalRoleIDs))
+	for _, id := range originalRoleIDs {
+		if exists[id] {
+			restore = append(restore, id)
+		}
+	}
+	return r.setRoles(ctx, guildID, userID, restore)
+}
+
+// changeRoles adds and removes individual roles, leaving the member's other
+// roles untouched. Roles that are already gone are skipped when removing.
+func (r *memberRolesResource) changeRoles(ctx context.Context, guildID, userID discord.Snowflake, add, remove []string) error {
+	for _, id := range add {
+		if err := r.client.AddGuildMemberRole(ctx, guildID, userID, discord.Snowflake(id)); err != nil {
+			return fmt.Errorf("adding role %s: %w", id, err)
+		}
+	}
+	for _, id := range remove {
+		if err := r.client.RemoveGuildMemberRole(ctx, guildID, userID, discord.Snowflake(id)); err != nil {
+			if discord.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("removing role %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// diffIDs returns the IDs to add and remove to go from one set of IDs to
+// another.
+func diffIDs(from, to []string) (add, remove []string) {
+	fromSet := make(map[string]bool, len(from))
+	for _, id := range from {
+		fromSet[id] = true
+	}
+	toSet := make(map[string]bool, len(to))
+	for _, id := range to {
+		toSet[id] = true
+		if !fromSet[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range from {
+		if !toSet[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+// memberRoleSet returns the member's role IDs as a set.
+func memberRoleSet(ctx context.Context, member *discord.Member) (types.Set, diag.Diagnostics) {
+	roleIDs := make([]string, len(member.Roles))
+	for i, id := range member.Roles {
+		roleIDs[i] = id.String()
+	}
+	return types.SetValueFrom(ctx, types.StringType, roleIDs)
 }
 
-// extractRoleIDs extracts role ID strings from a types.Set.
-func extractRoleIDs(ctx context.Context, rolesSet types.Set) ([]string, diag.Diagnostics) {
-	var roleIDs []string
-	diags := rolesSet.ElementsAs(ctx, &roleIDs, false)
-	return roleIDs, diags
+// extractIDs extracts ID strings from a types.Set.
+func extractIDs(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var ids []string
+	diags := set.ElementsAs(ctx, &ids, false)
+	return ids, diags
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccMemberRoles_basic(t *testing.T) {
@@ -24,6 +25,7 @@ func TestAccMemberRoles_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("discord_member_roles.test", "guild_id", guildID),
 					resource.TestCheckResourceAttr("discord_member_roles.test", "user_id", userID),
 					resource.TestCheckResourceAttrSet("discord_member_roles.test", "id"),
+					resource.TestCheckResourceAttr("discord_member_roles.test", "on_destroy", "restore"),
 				),
 			},
 			// ImportState
@@ -31,6 +33,67 @@ func TestAccMemberRoles_basic(t *testing.T) {
 				ResourceName:      "discord_member_roles.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Import records the roles held at import time as the originals.
+				ImportStateVerifyIgnore: []string{"original_roles"},
+			},
+		},
+	})
+}
+
+func TestAccMemberRoles_managedRolesOnly(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The role granted by discord_member_role is not tracked and survives.
+			{
+				Config: testAccMemberRolesConfig_managedRolesOnly(guildID, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_member_roles.test", "managed_roles_only", "true"),
+					resource.TestCheckResourceAttr("discord_member_roles.test", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("discord_member_roles.test", "roles.*", "discord_role.managed_listed", "id"),
+				),
+			},
+			// A second apply must see no drift from the unlisted role.
+			{
+				Config:   testAccMemberRolesConfig_managedRolesOnly(guildID, userID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccMemberRoles_switchToManagedRolesOnly(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Authoritative over both roles
+			{
+				Config: testAccMemberRolesConfig_switch(guildID, userID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_member_roles.test", "roles.#", "2"),
+				),
+			},
+			// Switching to managed_roles_only keeps the role that is no longer
+			// listed, so discord_member_role sees no drift.
+			{
+				Config: testAccMemberRolesConfig_switch(guildID, userID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_member_roles.test", "managed_roles_only", "true"),
+					resource.TestCheckResourceAttr("discord_member_roles.test", "roles.#", "1"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 			},
 		},
 	})
@@ -50,3 +113,65 @@ resource "discord_member_roles" "test" {
 }
 `, guildID, userID)
 }
+
+func testAccMemberRolesConfig_managedRolesOnly(guildID, userID string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "managed_listed" {
+  guild_id = %[1]q
+  name     = "tf-acc-member-roles-listed"
+}
+
+resource "discord_role" "managed_other" {
+  guild_id = %[1]q
+  name     = "tf-acc-member-roles-other"
+}
+
+resource "discord_member_role" "other" {
+  guild_id = %[1]q
+  user_id  = %[2]q
+  role_id  = discord_role.managed_other.id
+}
+
+resource "discord_member_roles" "test" {
+  guild_id           = %[1]q
+  user_id            = %[2]q
+  managed_roles_only = true
+  roles              = [discord_role.managed_listed.id]
+
+  depends_on = [discord_member_role.other]
+}
+`, guildID, userID)
+}
+
+func testAccMemberRolesConfig_switch(guildID, userID string, managed bool) string {
+	roles := "[discord_role.switch_listed.id, discord_role.switch_other.id]"
+	if managed {
+		roles = "[discord_role.switch_listed.id]"
+	}
+	return fmt.Sprintf(`
+resource "discord_role" "switch_listed" {
+  guild_id = %[1]q
+  name     = "tf-acc-member-roles-switch-listed"
+}
+
+resource "discord_role" "switch_other" {
+  guild_id = %[1]q
+  name     = "tf-acc-member-roles-switch-other"
+}
+
+resource "discord_member_role" "other" {
+  guild_id = %[1]q
+  user_id  = %[2]q
+  role_id  = discord_role.switch_other.id
+}
+
+resource "discord_member_roles" "test" {
+  guild_id           = %[1]q
+  user_id            = %[2]q
+  managed_roles_only = %[3]t
+  roles              = %[4]s
+
+  depends_on = [discord_member_role.other]
+}
+`, guildID, userID, managed, roles)
+}
@@ -0,0 +1,88 @@
+package member_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMember_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+	timeoutUntil := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberConfig_basic(guildID, userID, "tf-acc-nick"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_member.test", "nick", "tf-acc-nick"),
+					resource.TestCheckResourceAttr("discord_member.test", "mute", "false"),
+					resource.TestCheckResourceAttr("discord_member.test", "bypasses_verification", "true"),
+					resource.TestCheckNoResourceAttr("discord_member.test", "timeout_until"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update nickname and add a timeout
+			{
+				Config: testAccMemberConfig_timeout(guildID, userID, timeoutUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("discord_member.test", "nick"),
+					resource.TestCheckResourceAttr("discord_member.test", "timeout_until", timeoutUntil),
+					resource.TestCheckResourceAttr("discord_member.test", "bypasses_verification", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMember_timeoutTooLong(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+	timeoutUntil := time.Now().Add(29 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMemberConfig_timeout(guildID, userID, timeoutUntil),
+				ExpectError: regexp.MustCompile(`Timeout Too Long`),
+			},
+		},
+	})
+}
+
+func testAccMemberConfig_basic(guildID, userID, nick string) string {
+	return fmt.Sprintf(`
+resource "discord_member" "test" {
+  guild_id              = %[1]q
+  user_id               = %[2]q
+  nick                  = %[3]q
+  bypasses_verification = true
+}
+`, guildID, userID, nick)
+}
+
+func testAccMemberConfig_timeout(guildID, userID, timeoutUntil string) string {
+	return fmt.Sprintf(`
+resource "discord_member" "test" {
+  guild_id      = %[1]q
+  user_id       = %[2]q
+  timeout_until = %[3]q
+}
+`, guildID, userID, timeoutUntil)
+}
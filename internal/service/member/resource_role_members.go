@@ -0,0 +1,289 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &roleMembersResource{}
+	_ resource.ResourceWithConfigure   = &roleMembersResource{}
+	_ resource.ResourceWithImportState = &roleMembersResource{}
+)
+
+// roleMembersResource is the resource implementation.
+type roleMembersResource struct {
+	client *discord.Client
+}
+
+// roleMembersResourceModel maps the resource schema to a Go struct.
+type roleMembersResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	GuildID types.String `tfsdk:"guild_id"`
+	RoleID  types.String `tfsdk:"role_id"`
+	UserIDs types.Set    `tfsdk:"user_ids"`
+}
+
+// NewRoleMembersResource returns a new role members resource.
+func NewRoleMembersResource() resource.Resource {
+	return &roleMembersResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_members"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the resource.
+func (r *roleMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the set of users holding a Discord role. The set is authoritative: members not listed " +
+			"lose the role. Reading the current holders requires the privileged GUILD_MEMBERS intent.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The composite ID (guild_id/role_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Description: "The IDs of the users that hold the role.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Create grants the role to the listed users and removes it from everyone else.
+func (r *roleMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, diags := extractIDs(ctx, plan.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncHolders(ctx, plan, userIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Discord Role Members",
+			"Could not set members of role "+plan.RoleID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.GuildID.ValueString() + "/" + plan.RoleID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := discord.Snowflake(state.GuildID.ValueString())
+
+	roles, err := r.client.GetGuildRoles(ctx, guildID)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Role Members",
+			"Could not read roles for guild "+state.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	found := false
+	for _, role := range roles {
+		if role.ID.String() == state.RoleID.ValueString() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	holders, err := r.roleHolders(ctx, guildID, state.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Role Members",
+			"Could not list members of guild "+state.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	userIDs, diags := types.SetValueFrom(ctx, types.StringType, holders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.UserIDs = userIDs
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update adds and removes the role so that exactly the listed users hold it.
+func (r *roleMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state roleMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, diags := extractIDs(ctx, plan.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncHolders(ctx, plan, userIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Discord Role Members",
+			"Could not update members of role "+plan.RoleID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the role from the users in state.
+func (r *roleMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, diags := extractIDs(ctx, state.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.changeHolders(ctx, discord.Snowflake(state.GuildID.ValueString()), discord.Snowflake(state.RoleID.ValueString()), nil, userIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing Discord Role Members",
+			"Could not remove role "+state.RoleID.ValueString()+" from its members: "+err.Error(),
+		)
+	}
+}
+
+// ImportState allows importing by guild_id/role_id.
+func (r *roleMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'guild_id/role_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), parts[1])...)
+}
+
+// syncHolders reads the current holders of the role and adds or removes it so
+// that exactly the given users hold it.
+func (r *roleMembersResource) syncHolders(ctx context.Context, plan roleMembersResourceModel, userIDs []string) error {
+	guildID := discord.Snowflake(plan.GuildID.ValueString())
+
+	holders, err := r.roleHolders(ctx, guildID, plan.RoleID.ValueString())
+	if err != nil {
+		return fmt.Errorf("listing guild members: %w", err)
+	}
+
+	add, remove := diffIDs(holders, userIDs)
+	return r.changeHolders(ctx, guildID, discord.Snowflake(plan.RoleID.ValueString()), add, remove)
+}
+
+// roleHolders returns the IDs of the guild members that hold the role.
+func (r *roleMembersResource) roleHolders(ctx context.Context, guildID discord.Snowflake, roleID string) ([]string, error) {
+	members, err := r.client.ListGuildMembers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := []string{}
+	for _, member := range members {
+		if member.User != nil && hasRole(member, roleID) {
+			holders = append(holders, member.User.ID.String())
+		}
+	}
+	return holders, nil
+}
+
+// changeHolders grants the role to and removes it from individual members.
+// The requests share a rate limit bucket in the client, so large changes are
+// throttled rather than rejected. Members that have left the guild are
+// skipped when removing.
+func (r *roleMembersResource) changeHolders(ctx context.Context, guildID, roleID discord.Snowflake, add, remove []string) error {
+	for _, id := range add {
+		if err := r.client.AddGuildMemberRole(ctx, guildID, discord.Snowflake(id), roleID); err != nil {
+			return fmt.Errorf("adding role to user %s: %w", id, err)
+		}
+	}
+	for _, id := range remove {
+		if err := r.client.RemoveGuildMemberRole(ctx, guildID, discord.Snowflake(id), roleID); err != nil {
+			if discord.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("removing role from user %s: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package member_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleMembers_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	userID := os.Getenv("DISCORD_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckUser(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRoleMembersConfig_basic(guildID, fmt.Sprintf("%q", userID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_role_members.test", "role_id", "discord_role.role_members_test", "id"),
+					resource.TestCheckResourceAttr("discord_role_members.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("discord_role_members.test", "user_ids.*", userID),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_role_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove every holder
+			{
+				Config: testAccRoleMembersConfig_basic(guildID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_role_members.test", "user_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleMembersConfig_basic(guildID, userIDs string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "role_members_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-role-members"
+}
+
+resource "discord_role_members" "test" {
+  guild_id = %[1]q
+  role_id  = discord_role.role_members_test.id
+  user_ids = [%[2]s]
+}
+`, guildID, userIDs)
+}
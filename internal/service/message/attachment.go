@@ -0,0 +1,251 @@
+package message
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Discord's attachment limits.
+const (
+	maxAttachments                 = 10
+	maxAttachmentDescriptionLength = 1024
+)
+
+// spoilerPrefix marks an attachment as a spoiler when it starts the filename.
+const spoilerPrefix = "SPOILER_"
+
+// attachmentModel maps the attachment block schema data.
+type attachmentModel struct {
+	Path        types.String `tfsdk:"path"`
+	Filename    types.String `tfsdk:"filename"`
+	Description types.String `tfsdk:"description"`
+	Spoiler     types.Bool   `tfsdk:"spoiler"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	ID          types.String `tfsdk:"id"`
+	URL         types.String `tfsdk:"url"`
+}
+
+// attachmentBlock returns the schema for the attachment block.
+func attachmentBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("A local file uploaded with the message, at most %d per message. Attachments "+
+			"cannot be modified once sent, so changing a file's content, filename, description or spoiler flag "+
+			"uploads it again. Attachments are not imported.", maxAttachments),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(maxAttachments),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{
+					Description: "Path to the local file to upload.",
+					Required:    true,
+				},
+				"filename": schema.StringAttribute{
+					Description: "Filename shown in Discord. Defaults to the base name of `path`.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"description": schema.StringAttribute{
+					Description: fmt.Sprintf("Alt text of the attachment (up to %d characters).", maxAttachmentDescriptionLength),
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, maxAttachmentDescriptionLength),
+					},
+				},
+				"spoiler": schema.BoolAttribute{
+					Description: "Whether the attachment is hidden as a spoiler.",
+					Optional:    true,
+				},
+				"content_hash": schema.StringAttribute{
+					Description: "SHA-256 hash of the file content, used to detect changes.",
+					Computed:    true,
+				},
+				"id": schema.StringAttribute{
+					Description: "The ID of the uploaded attachment.",
+					Computed:    true,
+				},
+				"url": schema.StringAttribute{
+					Description: "The CDN URL of the uploaded attachment, without the expiring signature parameters. " +
+						"Discord signs the URL again when it is used in a message.",
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// planAttachments sets content_hash from the files on disk and carries the
+// ID and URL over from state for attachments that do not need uploading
+// again. Attachments whose path is not yet known are left unknown.
+func planAttachments(planned, prior []attachmentModel) ([]attachmentModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	used := make([]bool, len(prior))
+	for i := range planned {
+		a := &planned[i]
+		a.ContentHash = types.StringUnknown()
+		a.ID = types.StringUnknown()
+		a.URL = types.StringUnknown()
+		if a.Path.IsUnknown() {
+			continue
+		}
+
+		_, hash, err := readAttachment(a.Path.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("attachment").AtListIndex(i).AtName("path"),
+				"Error Reading Attachment", err.Error())
+			continue
+		}
+		a.ContentHash = types.StringValue(hash)
+
+		for j, p := range prior {
+			if !used[j] && !p.ID.IsNull() && sameAttachment(*a, p) {
+				used[j] = true
+				a.ID = p.ID
+				a.URL = p.URL
+				break
+			}
+		}
+	}
+	return planned, diags
+}
+
+// sameAttachment reports whether an uploaded attachment already matches the
+// planned one, so it can be kept rather than uploaded again.
+func sameAttachment(planned, uploaded attachmentModel) bool {
+	return planned.ContentHash.Equal(uploaded.ContentHash) &&
+		attachmentFilename(planned) == attachmentFilename(uploaded) &&
+		planned.Description.Equal(uploaded.Description) &&
+		planned.Spoiler.ValueBool() == uploaded.Spoiler.ValueBool()
+}
+
+// attachmentFilename returns the filename to upload an attachment as.
+func attachmentFilename(a attachmentModel) string {
+	name := filepath.Base(a.Path.ValueString())
+	if !a.Filename.IsNull() && !a.Filename.IsUnknown() {
+		name = a.Filename.ValueString()
+	}
+	if a.Spoiler.ValueBool() {
+		name = spoilerPrefix + name
+	}
+	return name
+}
+
+// readAttachment reads a file and returns its content and SHA-256 hash.
+func readAttachment(filePath string) ([]byte, string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not read attachment file: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return data, hex.EncodeToString(sum[:]), nil
+}
+
+// buildAttachments returns the attachment list and files for a create or
+// edit request. Attachments with a known ID are kept as they are; the others
+// are read from disk and uploaded.
+func buildAttachments(attachments []attachmentModel) ([]*discord.AttachmentParams, []*discord.File, error) {
+	params := make([]*discord.AttachmentParams, 0, len(attachments))
+	var files []*discord.File
+	for _, a := range attachments {
+		if !a.ID.IsNull() && !a.ID.IsUnknown() {
+			params = append(params, &discord.AttachmentParams{ID: discord.Snowflake(a.ID.ValueString())})
+			continue
+		}
+
+		data, _, err := readAttachment(a.Path.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", a.Path.ValueString(), err)
+		}
+		name := attachmentFilename(a)
+		params = append(params, &discord.AttachmentParams{
+			ID:          discord.Snowflake(strconv.Itoa(len(files))),
+			Filename:    &name,
+			Description: stringPointer(a.Description),
+		})
+		files = append(files, &discord.File{
+			Name:        name,
+			ContentType: mime.TypeByExtension(filepath.Ext(name)),
+			Data:        data,
+		})
+	}
+	return params, files, nil
+}
+
+// resolveAttachments fills in the ID and URL of newly uploaded attachments
+// from the message Discord returned. Discord lists new uploads after the
+// attachments that were kept, in upload order.
+func resolveAttachments(attachments []attachmentModel, uploaded []*discord.Attachment) []attachmentModel {
+	kept := map[string]bool{}
+	for _, a := range attachments {
+		if !a.ID.IsNull() && !a.ID.IsUnknown() {
+			kept[a.ID.ValueString()] = true
+		}
+	}
+	var added []*discord.Attachment
+	for _, u := range uploaded {
+		if !kept[u.ID.String()] {
+			added = append(added, u)
+		}
+	}
+
+	for i := range attachments {
+		a := &attachments[i]
+		if !a.ID.IsNull() && !a.ID.IsUnknown() {
+			continue
+		}
+		a.ID = types.StringNull()
+		a.URL = types.StringNull()
+		if len(added) == 0 {
+			continue
+		}
+		a.ID = types.StringValue(added[0].ID.String())
+		a.URL = types.StringValue(attachmentURL(added[0].URL))
+		added = added[1:]
+	}
+	return attachments
+}
+
+// refreshAttachments drops attachments from state that were removed from
+// the message outside of Terraform, so that they are uploaded again.
+func refreshAttachments(attachments []attachmentModel, current []*discord.Attachment) []attachmentModel {
+	present := map[string]bool{}
+	for _, c := range current {
+		present[c.ID.String()] = true
+	}
+	var result []attachmentModel
+	for _, a := range attachments {
+		if present[a.ID.ValueString()] {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// attachmentURL strips the expiring signature parameters from an attachment
+// URL so that it stays stable between reads.
+func attachmentURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.RawQuery = ""
+	return u.String()
+}
@@ -0,0 +1,985 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Discord's component limits, counted in characters where applicable.
+const (
+	maxActionRows             = 5
+	maxComponentsV2           = 40
+	maxTextDisplayLength      = 4000
+	maxRowButtons             = 5
+	maxSectionTexts           = 3
+	maxSelectOptions          = 25
+	maxSelectValues           = 25
+	maxCustomIDLength         = 100
+	maxButtonLabelLength      = 80
+	maxSelectPlaceholderLen   = 150
+	maxSelectOptionTextLength = 100
+)
+
+// Component type names as used in configuration.
+const (
+	componentActionRow   = "action_row"
+	componentTextDisplay = "text_display"
+	componentSection     = "section"
+	componentSeparator   = "separator"
+	componentContainer   = "container"
+)
+
+var buttonStyles = map[string]int{
+	"primary":   discord.ButtonStylePrimary,
+	"secondary": discord.ButtonStyleSecondary,
+	"success":   discord.ButtonStyleSuccess,
+	"danger":    discord.ButtonStyleDanger,
+	"link":      discord.ButtonStyleLink,
+}
+
+var selectTypes = map[string]int{
+	"string":      discord.ComponentTypeStringSelect,
+	"user":        discord.ComponentTypeUserSelect,
+	"role":        discord.ComponentTypeRoleSelect,
+	"mentionable": discord.ComponentTypeMentionableSelect,
+	"channel":     discord.ComponentTypeChannelSelect,
+}
+
+var separatorSpacings = map[string]int{
+	"small": discord.SeparatorSpacingSmall,
+	"large": discord.SeparatorSpacingLarge,
+}
+
+// componentModel maps a top-level component block. It holds every attribute
+// of componentItemModel plus the container-only ones.
+type componentModel struct {
+	Type         types.String         `tfsdk:"type"`
+	Content      types.String         `tfsdk:"content"`
+	Texts        []types.String       `tfsdk:"texts"`
+	ThumbnailURL types.String         `tfsdk:"thumbnail_url"`
+	Divider      types.Bool           `tfsdk:"divider"`
+	Spacing      types.String         `tfsdk:"spacing"`
+	AccentColor  types.Int64          `tfsdk:"accent_color"`
+	Spoiler      types.Bool           `tfsdk:"spoiler"`
+	Button       []buttonModel        `tfsdk:"button"`
+	Select       []selectModel        `tfsdk:"select"`
+	Component    []componentItemModel `tfsdk:"component"`
+}
+
+// componentItemModel maps a component block nested in a container.
+type componentItemModel struct {
+	Type         types.String   `tfsdk:"type"`
+	Content      types.String   `tfsdk:"content"`
+	Texts        []types.String `tfsdk:"texts"`
+	ThumbnailURL types.String   `tfsdk:"thumbnail_url"`
+	Divider      types.Bool     `tfsdk:"divider"`
+	Spacing      types.String   `tfsdk:"spacing"`
+	Button       []buttonModel  `tfsdk:"button"`
+	Select       []selectModel  `tfsdk:"select"`
+}
+
+// buttonModel maps the button block schema data.
+type buttonModel struct {
+	Style    types.String `tfsdk:"style"`
+	Label    types.String `tfsdk:"label"`
+	Emoji    types.String `tfsdk:"emoji"`
+	CustomID types.String `tfsdk:"custom_id"`
+	URL      types.String `tfsdk:"url"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+}
+
+// selectModel maps the select block schema data.
+type selectModel struct {
+	Type         types.String        `tfsdk:"type"`
+	CustomID     types.String        `tfsdk:"custom_id"`
+	Placeholder  types.String        `tfsdk:"placeholder"`
+	MinValues    types.Int64         `tfsdk:"min_values"`
+	MaxValues    types.Int64         `tfsdk:"max_values"`
+	Disabled     types.Bool          `tfsdk:"disabled"`
+	ChannelTypes []types.Int64       `tfsdk:"channel_types"`
+	Option       []selectOptionModel `tfsdk:"option"`
+}
+
+// selectOptionModel maps the select option block schema data.
+type selectOptionModel struct {
+	Label       types.String `tfsdk:"label"`
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+	Emoji       types.String `tfsdk:"emoji"`
+	Default     types.Bool   `tfsdk:"default"`
+}
+
+// item returns the attributes the top-level component shares with components
+// nested in a container.
+func (c componentModel) item() componentItemModel {
+	return componentItemModel{
+		Type:         c.Type,
+		Content:      c.Content,
+		Texts:        c.Texts,
+		ThumbnailURL: c.ThumbnailURL,
+		Divider:      c.Divider,
+		Spacing:      c.Spacing,
+		Button:       c.Button,
+		Select:       c.Select,
+	}
+}
+
+// componentItemAttributes returns the schema attributes shared by top-level
+// and nested components.
+func componentItemAttributes(allowed []string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "The component type. One of " + quotedList(allowed) + ". Only `action_row` is allowed " +
+				"unless `components_v2` is enabled.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(allowed...),
+			},
+		},
+		"content": schema.StringAttribute{
+			Description: "Markdown text of a `text_display` component.",
+			Optional:    true,
+		},
+		"texts": schema.ListAttribute{
+			Description: fmt.Sprintf("Markdown text of a `section` component, 1 to %d entries.", maxSectionTexts),
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, maxSectionTexts),
+			},
+		},
+		"thumbnail_url": schema.StringAttribute{
+			Description: "URL of the thumbnail shown beside a `section`. Conflicts with a `button` accessory.",
+			Optional:    true,
+		},
+		"divider": schema.BoolAttribute{
+			Description: "Whether a `separator` draws a visible line. Discord defaults to `true`.",
+			Optional:    true,
+		},
+		"spacing": schema.StringAttribute{
+			Description: "Padding of a `separator`, `small` or `large`. Discord defaults to `small`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("small", "large"),
+			},
+		},
+	}
+}
+
+// componentItemBlocks returns the schema blocks shared by top-level and nested
+// components.
+func componentItemBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"button": schema.ListNestedBlock{
+			Description: fmt.Sprintf("A button. An `action_row` holds up to %d buttons; a `section` may use one "+
+				"as its accessory.", maxRowButtons),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(maxRowButtons),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"style": schema.StringAttribute{
+						Description: "Button style. One of `primary`, `secondary`, `success`, `danger` or `link`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("primary", "secondary", "success", "danger", "link"),
+						},
+					},
+					"label": schema.StringAttribute{
+						Description: fmt.Sprintf("Text on the button (up to %d characters).", maxButtonLabelLength),
+						Optional:    true,
+					},
+					"emoji": schema.StringAttribute{
+						Description: "A unicode emoji or the ID of a custom emoji shown on the button.",
+						Optional:    true,
+					},
+					"custom_id": schema.StringAttribute{
+						Description: fmt.Sprintf("Identifier sent with the interaction (up to %d characters). Required "+
+							"for all styles except `link`.", maxCustomIDLength),
+						Optional: true,
+					},
+					"url": schema.StringAttribute{
+						Description: "URL opened by a `link` button.",
+						Optional:    true,
+					},
+					"disabled": schema.BoolAttribute{
+						Description: "Whether the button is disabled.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"select": schema.ListNestedBlock{
+			Description: "A select menu. An `action_row` holds at most one select and no buttons.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Select type. One of `string`, `user`, `role`, `mentionable` or `channel`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("string", "user", "role", "mentionable", "channel"),
+						},
+					},
+					"custom_id": schema.StringAttribute{
+						Description: fmt.Sprintf("Identifier sent with the interaction (up to %d characters).", maxCustomIDLength),
+						Required:    true,
+					},
+					"placeholder": schema.StringAttribute{
+						Description: fmt.Sprintf("Text shown when nothing is selected (up to %d characters).", maxSelectPlaceholderLen),
+						Optional:    true,
+					},
+					"min_values": schema.Int64Attribute{
+						Description: fmt.Sprintf("Minimum number of items that must be chosen, 0 to %d. Discord defaults to 1.", maxSelectValues),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, maxSelectValues),
+						},
+					},
+					"max_values": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of items that can be chosen, 1 to %d. Discord defaults to 1.", maxSelectValues),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, maxSelectValues),
+						},
+					},
+					"disabled": schema.BoolAttribute{
+						Description: "Whether the select is disabled.",
+						Optional:    true,
+					},
+					"channel_types": schema.ListAttribute{
+						Description: "Channel types offered by a `channel` select.",
+						Optional:    true,
+						ElementType: types.Int64Type,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"option": schema.ListNestedBlock{
+						Description: fmt.Sprintf("A choice of a `string` select, 1 to %d per select.", maxSelectOptions),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(maxSelectOptions),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"label": schema.StringAttribute{
+									Description: fmt.Sprintf("User-facing name of the option (up to %d characters).", maxSelectOptionTextLength),
+									Required:    true,
+								},
+								"value": schema.StringAttribute{
+									Description: fmt.Sprintf("Value sent with the interaction (up to %d characters).", maxSelectOptionTextLength),
+									Required:    true,
+								},
+								"description": schema.StringAttribute{
+									Description: fmt.Sprintf("Additional description of the option (up to %d characters).", maxSelectOptionTextLength),
+									Optional:    true,
+								},
+								"emoji": schema.StringAttribute{
+									Description: "A unicode emoji or the ID of a custom emoji shown with the option.",
+									Optional:    true,
+								},
+								"default": schema.BoolAttribute{
+									Description: "Whether the option is selected by default.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// componentBlock returns the schema for the top-level component block.
+func componentBlock() schema.ListNestedBlock {
+	attributes := componentItemAttributes([]string{
+		componentActionRow, componentTextDisplay, componentSection, componentSeparator, componentContainer,
+	})
+	attributes["accent_color"] = schema.Int64Attribute{
+		Description: "Color of the bar along the side of a `container`.",
+		Optional:    true,
+	}
+	attributes["spoiler"] = schema.BoolAttribute{
+		Description: "Whether a `container` is blurred as a spoiler.",
+		Optional:    true,
+	}
+
+	blocks := componentItemBlocks()
+	blocks["component"] = schema.ListNestedBlock{
+		Description: "A component inside a `container`.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: componentItemAttributes([]string{
+				componentActionRow, componentTextDisplay, componentSection, componentSeparator,
+			}),
+			Blocks: componentItemBlocks(),
+		},
+	}
+
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("Interactive or layout components, in display order. Without `components_v2` only "+
+			"`action_row` components are allowed, at most %d. With `components_v2` up to %d components may be "+
+			"nested in total.", maxActionRows, maxComponentsV2),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+// validateComponentsConfig checks the component blocks in a configuration
+// against Discord's component limits. Unknown values are skipped.
+func validateComponentsConfig(ctx context.Context, config tfsdk.Config, componentsPath path.Path, v2 bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var list types.List
+	diags.Append(config.GetAttribute(ctx, componentsPath, &list)...)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return diags
+	}
+
+	var components []componentModel
+	if d := list.ElementsAs(ctx, &components, false); d.HasError() {
+		// Partially unknown blocks are validated again once known.
+		return diags
+	}
+
+	v := &componentValidator{v2: v2, customIDs: map[string]bool{}}
+	if !v2 && len(components) > maxActionRows {
+		v.diags.AddAttributeError(componentsPath, "Component Limit Exceeded",
+			fmt.Sprintf("Discord allows at most %d action rows per message, got %d.", maxActionRows, len(components)))
+	}
+
+	for i, c := range components {
+		p := componentsPath.AtListIndex(i)
+		v.count++
+		v.item(c.item(), p)
+
+		isContainer := c.Type.ValueString() == componentContainer
+		if !isContainer {
+			v.forbid(!c.AccentColor.IsNull(), p.AtName("accent_color"), componentContainer)
+			v.forbid(!c.Spoiler.IsNull(), p.AtName("spoiler"), componentContainer)
+			v.forbid(len(c.Component) > 0, p.AtName("component"), componentContainer)
+			continue
+		}
+		if len(c.Component) == 0 {
+			v.diags.AddAttributeError(p.AtName("component"), "Missing Container Components",
+				"A container must hold at least one component.")
+		}
+		for j, child := range c.Component {
+			v.count++
+			v.item(child, p.AtName("component").AtListIndex(j))
+		}
+	}
+
+	if v2 && v.count > maxComponentsV2 {
+		v.diags.AddAttributeError(componentsPath, "Component Limit Exceeded",
+			fmt.Sprintf("Discord allows at most %d components per message, got %d.", maxComponentsV2, v.count))
+	}
+	if v.textLength > maxTextDisplayLength {
+		v.diags.AddAttributeError(componentsPath, "Component Limit Exceeded",
+			fmt.Sprintf("The combined text of all components may not exceed %d characters, got %d.",
+				maxTextDisplayLength, v.textLength))
+	}
+
+	diags.Append(v.diags...)
+	return diags
+}
+
+// componentValidator accumulates the state needed to validate a message's
+// components as a whole.
+type componentValidator struct {
+	v2         bool
+	count      int
+	textLength int
+	customIDs  map[string]bool
+	diags      diag.Diagnostics
+}
+
+// forbid reports an attribute that is set on a component type that does not
+// use it.
+func (v *componentValidator) forbid(set bool, p path.Path, allowed string) {
+	if set {
+		v.diags.AddAttributeError(p, "Invalid Component Attribute",
+			fmt.Sprintf("This attribute is only valid on %s components.", allowed))
+	}
+}
+
+// length reports a value longer than limit characters and returns its length.
+func (v *componentValidator) length(value types.String, p path.Path, limit int) int {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+	n := utf8.RuneCountInString(value.ValueString())
+	if n > limit {
+		v.diags.AddAttributeError(p, "Component Limit Exceeded",
+			fmt.Sprintf("Discord allows at most %d characters here, got %d.", limit, n))
+	}
+	return n
+}
+
+// customID checks the length and uniqueness of a custom ID.
+func (v *componentValidator) customID(value types.String, p path.Path) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	id := value.ValueString()
+	if id == "" {
+		v.diags.AddAttributeError(p, "Invalid Custom ID", "custom_id must not be empty.")
+		return
+	}
+	v.length(value, p, maxCustomIDLength)
+	if v.customIDs[id] {
+		v.diags.AddAttributeError(p, "Duplicate Custom ID",
+			fmt.Sprintf("custom_id %q is used more than once. Custom IDs must be unique within a message.", id))
+	}
+	v.customIDs[id] = true
+}
+
+// item validates a single component and its buttons and selects.
+func (v *componentValidator) item(c componentItemModel, p path.Path) {
+	if c.Type.IsUnknown() {
+		return
+	}
+	t := c.Type.ValueString()
+	if !v.v2 && t != componentActionRow {
+		v.diags.AddAttributeError(p.AtName("type"), "Components V2 Required",
+			fmt.Sprintf("%s components require components_v2 to be enabled.", t))
+	}
+
+	v.forbid(!c.Content.IsNull() && t != componentTextDisplay, p.AtName("content"), componentTextDisplay)
+	v.forbid(c.Texts != nil && t != componentSection, p.AtName("texts"), componentSection)
+	v.forbid(!c.ThumbnailURL.IsNull() && t != componentSection, p.AtName("thumbnail_url"), componentSection)
+	v.forbid(!c.Divider.IsNull() && t != componentSeparator, p.AtName("divider"), componentSeparator)
+	v.forbid(!c.Spacing.IsNull() && t != componentSeparator, p.AtName("spacing"), componentSeparator)
+	v.forbid(len(c.Select) > 0 && t != componentActionRow, p.AtName("select"), componentActionRow)
+	v.forbid(len(c.Button) > 0 && t != componentActionRow && t != componentSection, p.AtName("button"),
+		componentActionRow+" and "+componentSection)
+
+	switch t {
+	case componentActionRow:
+		switch {
+		case len(c.Button) == 0 && len(c.Select) == 0:
+			v.diags.AddAttributeError(p, "Empty Action Row", "An action_row must hold buttons or a select.")
+		case len(c.Button) > 0 && len(c.Select) > 0:
+			v.diags.AddAttributeError(p, "Invalid Action Row",
+				"An action_row holds either up to 5 buttons or a single select, not both.")
+		}
+	case componentTextDisplay:
+		if c.Content.IsNull() {
+			v.diags.AddAttributeError(p.AtName("content"), "Missing Text", "A text_display requires content.")
+		}
+		v.textLength += v.length(c.Content, p.AtName("content"), maxTextDisplayLength)
+	case componentSection:
+		if len(c.Texts) == 0 {
+			v.diags.AddAttributeError(p.AtName("texts"), "Missing Text", "A section requires at least one entry in texts.")
+		}
+		for i, text := range c.Texts {
+			v.count++
+			v.textLength += v.length(text, p.AtName("texts").AtListIndex(i), maxTextDisplayLength)
+		}
+		accessories := len(c.Button) + boolToInt(!c.ThumbnailURL.IsNull())
+		if accessories != 1 {
+			v.diags.AddAttributeError(p, "Invalid Section Accessory",
+				"A section requires exactly one accessory: either thumbnail_url or a single button block.")
+		}
+		v.count += accessories
+	}
+
+	for i, b := range c.Button {
+		v.count++
+		v.button(b, p.AtName("button").AtListIndex(i))
+	}
+	for i, s := range c.Select {
+		v.count++
+		v.selectMenu(s, p.AtName("select").AtListIndex(i))
+	}
+}
+
+// button validates a button against the rules for its style.
+func (v *componentValidator) button(b buttonModel, p path.Path) {
+	v.length(b.Label, p.AtName("label"), maxButtonLabelLength)
+	if b.Label.IsNull() && b.Emoji.IsNull() {
+		v.diags.AddAttributeError(p, "Missing Button Label", "A button requires a label, an emoji or both.")
+	}
+
+	if b.Style.IsUnknown() {
+		return
+	}
+	if b.Style.ValueString() == "link" {
+		if b.URL.IsNull() {
+			v.diags.AddAttributeError(p.AtName("url"), "Missing Button URL", "A link button requires url.")
+		}
+		if !b.CustomID.IsNull() {
+			v.diags.AddAttributeError(p.AtName("custom_id"), "Invalid Button Custom ID",
+				"A link button cannot have a custom_id.")
+		}
+		return
+	}
+	if b.CustomID.IsNull() {
+		v.diags.AddAttributeError(p.AtName("custom_id"), "Missing Button Custom ID",
+			"Buttons other than link buttons require custom_id.")
+	}
+	if !b.URL.IsNull() {
+		v.diags.AddAttributeError(p.AtName("url"), "Invalid Button URL", "Only link buttons can have a url.")
+	}
+	v.customID(b.CustomID, p.AtName("custom_id"))
+}
+
+// selectMenu validates a select menu and its options.
+func (v *componentValidator) selectMenu(s selectModel, p path.Path) {
+	v.customID(s.CustomID, p.AtName("custom_id"))
+	v.length(s.Placeholder, p.AtName("placeholder"), maxSelectPlaceholderLen)
+
+	if !s.MinValues.IsNull() && !s.MinValues.IsUnknown() && !s.MaxValues.IsNull() && !s.MaxValues.IsUnknown() &&
+		s.MinValues.ValueInt64() > s.MaxValues.ValueInt64() {
+		v.diags.AddAttributeError(p.AtName("min_values"), "Invalid Select Values",
+			"min_values must not be greater than max_values.")
+	}
+
+	if s.Type.IsUnknown() {
+		return
+	}
+	t := s.Type.ValueString()
+	if t != "channel" && s.ChannelTypes != nil {
+		v.diags.AddAttributeError(p.AtName("channel_types"), "Invalid Select Attribute",
+			"channel_types is only valid on channel selects.")
+	}
+	if t != "string" {
+		if len(s.Option) > 0 {
+			v.diags.AddAttributeError(p.AtName("option"), "Invalid Select Attribute",
+				"option blocks are only valid on string selects.")
+		}
+		return
+	}
+
+	if len(s.Option) == 0 {
+		v.diags.AddAttributeError(p.AtName("option"), "Missing Select Options",
+			"A string select requires at least one option block.")
+	}
+	if !s.MaxValues.IsNull() && !s.MaxValues.IsUnknown() && int(s.MaxValues.ValueInt64()) > len(s.Option) {
+		v.diags.AddAttributeError(p.AtName("max_values"), "Invalid Select Values",
+			fmt.Sprintf("max_values is %d but the select only has %d options.", s.MaxValues.ValueInt64(), len(s.Option)))
+	}
+	values := map[string]bool{}
+	for i, o := range s.Option {
+		op := p.AtName("option").AtListIndex(i)
+		v.length(o.Label, op.AtName("label"), maxSelectOptionTextLength)
+		v.length(o.Value, op.AtName("value"), maxSelectOptionTextLength)
+		v.length(o.Description, op.AtName("description"), maxSelectOptionTextLength)
+		if !o.Value.IsNull() && !o.Value.IsUnknown() {
+			if values[o.Value.ValueString()] {
+				v.diags.AddAttributeError(op.AtName("value"), "Duplicate Select Option",
+					fmt.Sprintf("Option value %q is used more than once.", o.Value.ValueString()))
+			}
+			values[o.Value.ValueString()] = true
+		}
+	}
+}
+
+// buildComponents converts the component models to Discord API components.
+func buildComponents(components []componentModel) []*discord.Component {
+	if len(components) == 0 {
+		return nil
+	}
+	result := make([]*discord.Component, 0, len(components))
+	for _, c := range components {
+		if c.Type.ValueString() != componentContainer {
+			result = append(result, buildComponentItem(c.item()))
+			continue
+		}
+
+		container := &discord.Component{Type: discord.ComponentTypeContainer}
+		if !c.AccentColor.IsNull() && !c.AccentColor.IsUnknown() {
+			color := int(c.AccentColor.ValueInt64())
+			container.AccentColor = &color
+		}
+		container.Spoiler = boolPointer(c.Spoiler)
+		for _, child := range c.Component {
+			container.Components = append(container.Components, buildComponentItem(child))
+		}
+		result = append(result, container)
+	}
+	return result
+}
+
+// buildComponentItem converts a non-container component model to a Discord
+// API component.
+func buildComponentItem(c componentItemModel) *discord.Component {
+	switch c.Type.ValueString() {
+	case componentTextDisplay:
+		return &discord.Component{
+			Type:    discord.ComponentTypeTextDisplay,
+			Content: stringPointer(c.Content),
+		}
+	case componentSection:
+		section := &discord.Component{Type: discord.ComponentTypeSection}
+		for _, text := range c.Texts {
+			section.Components = append(section.Components, &discord.Component{
+				Type:    discord.ComponentTypeTextDisplay,
+				Content: stringPointer(text),
+			})
+		}
+		if len(c.Button) > 0 {
+			section.Accessory = buildButton(c.Button[0])
+		} else if !c.ThumbnailURL.IsNull() && !c.ThumbnailURL.IsUnknown() {
+			section.Accessory = &discord.Component{
+				Type:  discord.ComponentTypeThumbnail,
+				Media: &discord.UnfurledMediaItem{URL: c.ThumbnailURL.ValueString()},
+			}
+		}
+		return section
+	case componentSeparator:
+		separator := &discord.Component{
+			Type:    discord.ComponentTypeSeparator,
+			Divider: boolPointer(c.Divider),
+		}
+		if spacing, ok := separatorSpacings[c.Spacing.ValueString()]; ok {
+			separator.Spacing = &spacing
+		}
+		return separator
+	}
+
+	row := &discord.Component{Type: discord.ComponentTypeActionRow}
+	for _, b := range c.Button {
+		row.Components = append(row.Components, buildButton(b))
+	}
+	for _, s := range c.Select {
+		row.Components = append(row.Components, buildSelect(s))
+	}
+	return row
+}
+
+// buildButton converts a button model to a Discord API component.
+func buildButton(b buttonModel) *discord.Component {
+	style := buttonStyles[b.Style.ValueString()]
+	return &discord.Component{
+		Type:     discord.ComponentTypeButton,
+		Style:    &style,
+		Label:    stringPointer(b.Label),
+		Emoji:    buildComponentEmoji(b.Emoji),
+		CustomID: stringPointer(b.CustomID),
+		URL:      stringPointer(b.URL),
+		Disabled: boolPointer(b.Disabled),
+	}
+}
+
+// buildSelect converts a select model to a Discord API component.
+func buildSelect(s selectModel) *discord.Component {
+	component := &discord.Component{
+		Type:        selectTypes[s.Type.ValueString()],
+		CustomID:    stringPointer(s.CustomID),
+		Placeholder: stringPointer(s.Placeholder),
+		MinValues:   intPointer(s.MinValues),
+		MaxValues:   intPointer(s.MaxValues),
+		Disabled:    boolPointer(s.Disabled),
+	}
+	for _, t := range s.ChannelTypes {
+		component.ChannelTypes = append(component.ChannelTypes, int(t.ValueInt64()))
+	}
+	for _, o := range s.Option {
+		component.Options = append(component.Options, &discord.SelectOption{
+			Label:       o.Label.ValueString(),
+			Value:       o.Value.ValueString(),
+			Description: stringPointer(o.Description),
+			Emoji:       buildComponentEmoji(o.Emoji),
+			Default:     boolPointer(o.Default),
+		})
+	}
+	return component
+}
+
+// buildComponentEmoji converts an emoji attribute, either a unicode emoji or
+// a custom emoji ID, to a partial Discord emoji.
+func buildComponentEmoji(value types.String) *discord.Emoji {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	s := value.ValueString()
+	if _, err := strconv.ParseUint(s, 10, 64); err == nil {
+		id := discord.Snowflake(s)
+		return &discord.Emoji{ID: &id}
+	}
+	return &discord.Emoji{Name: &s}
+}
+
+// flattenComponents converts Discord API components to component models.
+// Attributes left unset in prior stay null when Discord reports its default
+// for them. Component types the resource cannot manage are skipped.
+func flattenComponents(components []*discord.Component, prior []componentModel) []componentModel {
+	if len(components) == 0 {
+		return nil
+	}
+	result := make([]componentModel, 0, len(components))
+	for i, c := range components {
+		var p componentModel
+		if i < len(prior) {
+			p = prior[i]
+		}
+
+		if c.Type != discord.ComponentTypeContainer {
+			item, ok := flattenComponentItem(c, p.item())
+			if !ok {
+				continue
+			}
+			result = append(result, componentModel{
+				Type:         item.Type,
+				Content:      item.Content,
+				Texts:        item.Texts,
+				ThumbnailURL: item.ThumbnailURL,
+				Divider:      item.Divider,
+				Spacing:      item.Spacing,
+				AccentColor:  types.Int64Null(),
+				Spoiler:      types.BoolNull(),
+				Button:       item.Button,
+				Select:       item.Select,
+			})
+			continue
+		}
+
+		m := componentModel{
+			Type:         types.StringValue(componentContainer),
+			Content:      types.StringNull(),
+			ThumbnailURL: types.StringNull(),
+			Divider:      types.BoolNull(),
+			Spacing:      types.StringNull(),
+			AccentColor:  types.Int64Null(),
+			Spoiler:      flattenBool(c.Spoiler, false, p.Spoiler),
+		}
+		if c.AccentColor != nil {
+			m.AccentColor = types.Int64Value(int64(*c.AccentColor))
+		}
+		for j, child := range c.Components {
+			var pc componentItemModel
+			if j < len(p.Component) {
+				pc = p.Component[j]
+			}
+			if item, ok := flattenComponentItem(child, pc); ok {
+				m.Component = append(m.Component, item)
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// flattenComponentItem converts a non-container Discord API component to a
+// component model. It reports false for unsupported component types.
+func flattenComponentItem(c *discord.Component, prior componentItemModel) (componentItemModel, bool) {
+	m := componentItemModel{
+		Content:      types.StringNull(),
+		ThumbnailURL: types.StringNull(),
+		Divider:      types.BoolNull(),
+		Spacing:      types.StringNull(),
+	}
+
+	switch c.Type {
+	case discord.ComponentTypeActionRow:
+		m.Type = types.StringValue(componentActionRow)
+		for i, child := range c.Components {
+			if child.Type == discord.ComponentTypeButton {
+				var pb buttonModel
+				if i < len(prior.Button) {
+					pb = prior.Button[i]
+				}
+				m.Button = append(m.Button, flattenButton(child, pb))
+				continue
+			}
+			var ps selectModel
+			if len(m.Select) < len(prior.Select) {
+				ps = prior.Select[len(m.Select)]
+			}
+			m.Select = append(m.Select, flattenSelect(child, ps))
+		}
+	case discord.ComponentTypeTextDisplay:
+		m.Type = types.StringValue(componentTextDisplay)
+		m.Content = stringValue(c.Content)
+	case discord.ComponentTypeSection:
+		m.Type = types.StringValue(componentSection)
+		for _, text := range c.Components {
+			m.Texts = append(m.Texts, stringValue(text.Content))
+		}
+		if a := c.Accessory; a != nil {
+			switch a.Type {
+			case discord.ComponentTypeButton:
+				var pb buttonModel
+				if len(prior.Button) > 0 {
+					pb = prior.Button[0]
+				}
+				m.Button = []buttonModel{flattenButton(a, pb)}
+			case discord.ComponentTypeThumbnail:
+				if a.Media != nil {
+					m.ThumbnailURL = types.StringValue(a.Media.URL)
+				}
+			}
+		}
+	case discord.ComponentTypeSeparator:
+		m.Type = types.StringValue(componentSeparator)
+		m.Divider = flattenBool(c.Divider, true, prior.Divider)
+		if c.Spacing != nil && *c.Spacing == discord.SeparatorSpacingLarge {
+			m.Spacing = types.StringValue("large")
+		} else if !prior.Spacing.IsNull() {
+			m.Spacing = types.StringValue("small")
+		}
+	default:
+		return m, false
+	}
+	return m, true
+}
+
+// flattenButton converts a Discord API button to a button model.
+func flattenButton(c *discord.Component, prior buttonModel) buttonModel {
+	m := buttonModel{
+		Style:    types.StringNull(),
+		Label:    stringValue(c.Label),
+		Emoji:    flattenComponentEmoji(c.Emoji),
+		CustomID: stringValue(c.CustomID),
+		URL:      stringValue(c.URL),
+		Disabled: flattenBool(c.Disabled, false, prior.Disabled),
+	}
+	for name, value := range buttonStyles {
+		if c.Style != nil && *c.Style == value {
+			m.Style = types.StringValue(name)
+		}
+	}
+	return m
+}
+
+// flattenSelect converts a Discord API select menu to a select model.
+func flattenSelect(c *discord.Component, prior selectModel) selectModel {
+	m := selectModel{
+		Type:        types.StringNull(),
+		CustomID:    stringValue(c.CustomID),
+		Placeholder: stringValue(c.Placeholder),
+		MinValues:   flattenInt(c.MinValues, 1, prior.MinValues),
+		MaxValues:   flattenInt(c.MaxValues, 1, prior.MaxValues),
+		Disabled:    flattenBool(c.Disabled, false, prior.Disabled),
+	}
+	for name, value := range selectTypes {
+		if c.Type == value {
+			m.Type = types.StringValue(name)
+		}
+	}
+	for _, t := range c.ChannelTypes {
+		m.ChannelTypes = append(m.ChannelTypes, types.Int64Value(int64(t)))
+	}
+	for i, o := range c.Options {
+		var po selectOptionModel
+		if i < len(prior.Option) {
+			po = prior.Option[i]
+		}
+		m.Option = append(m.Option, selectOptionModel{
+			Label:       types.StringValue(o.Label),
+			Value:       types.StringValue(o.Value),
+			Description: stringValue(o.Description),
+			Emoji:       flattenComponentEmoji(o.Emoji),
+			Default:     flattenBool(o.Default, false, po.Default),
+		})
+	}
+	return m
+}
+
+// flattenComponentEmoji converts a partial Discord emoji to the emoji
+// attribute: the ID of a custom emoji, or the unicode emoji itself.
+func flattenComponentEmoji(e *discord.Emoji) types.String {
+	switch {
+	case e == nil:
+		return types.StringNull()
+	case e.ID != nil:
+		return types.StringValue(e.ID.String())
+	case e.Name != nil:
+		return types.StringValue(*e.Name)
+	}
+	return types.StringNull()
+}
+
+// flattenBool converts an optional boolean, keeping the attribute null when
+// it was unset in prior and Discord reports the default.
+func flattenBool(v *bool, def bool, prior types.Bool) types.Bool {
+	value := def
+	if v != nil {
+		value = *v
+	}
+	if value == def && prior.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
+
+// flattenInt converts an optional integer, keeping the attribute null when it
+// was unset in prior and Discord reports the default.
+func flattenInt(v *int, def int, prior types.Int64) types.Int64 {
+	value := def
+	if v != nil {
+		value = *v
+	}
+	if value == def && prior.IsNull() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(value))
+}
+
+// stringPointer returns a pointer to a known string value, or nil.
+func stringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+// boolPointer returns a pointer to a known bool value, or nil.
+func boolPointer(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	b := v.ValueBool()
+	return &b
+}
+
+// intPointer returns a pointer to a known integer value, or nil.
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// boolToInt returns 1 for true and 0 for false.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// quotedList formats values as a Markdown list of code spans.
+func quotedList(values []string) string {
+	s := ""
+	for i, v := range values {
+		switch {
+		case i == 0:
+		case i == len(values)-1:
+			s += " or "
+		default:
+			s += ", "
+		}
+		s += "`" + v + "`"
+	}
+	return s
+}
@@ -0,0 +1,391 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &messageDataSource{}
+	_ datasource.DataSourceWithConfigure = &messageDataSource{}
+)
+
+// messageDataSource is the data source implementation.
+type messageDataSource struct {
+	client *discord.Client
+}
+
+// messageDataModel maps a message as read by the message data sources.
+type messageDataModel struct {
+	ID              types.String                 `tfsdk:"id"`
+	ChannelID       types.String                 `tfsdk:"channel_id"`
+	AuthorID        types.String                 `tfsdk:"author_id"`
+	AuthorUsername  types.String                 `tfsdk:"author_username"`
+	Content         types.String                 `tfsdk:"content"`
+	Timestamp       types.String                 `tfsdk:"timestamp"`
+	EditedTimestamp types.String                 `tfsdk:"edited_timestamp"`
+	Pinned          types.Bool                   `tfsdk:"pinned"`
+	Type            types.Int64                  `tfsdk:"type"`
+	Embeds          []messageEmbedDataModel      `tfsdk:"embeds"`
+	Attachments     []messageAttachmentDataModel `tfsdk:"attachments"`
+}
+
+// messageEmbedDataModel maps an embed of a message read by the message data
+// sources.
+type messageEmbedDataModel struct {
+	Type         types.String      `tfsdk:"type"`
+	Title        types.String      `tfsdk:"title"`
+	Description  types.String      `tfsdk:"description"`
+	URL          types.String      `tfsdk:"url"`
+	Color        types.Int64       `tfsdk:"color"`
+	Timestamp    types.String      `tfsdk:"timestamp"`
+	FooterText   types.String      `tfsdk:"footer_text"`
+	ImageURL     types.String      `tfsdk:"image_url"`
+	ThumbnailURL types.String      `tfsdk:"thumbnail_url"`
+	AuthorName   types.String      `tfsdk:"author_name"`
+	Fields       []embedFieldModel `tfsdk:"fields"`
+}
+
+// messageAttachmentDataModel maps an attachment of a message read by the
+// message data sources.
+type messageAttachmentDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Filename    types.String `tfsdk:"filename"`
+	Description types.String `tfsdk:"description"`
+	ContentType types.String `tfsdk:"content_type"`
+	Size        types.Int64  `tfsdk:"size"`
+	URL         types.String `tfsdk:"url"`
+}
+
+// NewMessageDataSource returns a new message data source.
+func NewMessageDataSource() datasource.DataSource {
+	return &messageDataSource{}
+}
+
+// messageEmbedDataAttrTypes returns the attr.Type map for an embed object.
+func messageEmbedDataAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":          types.StringType,
+		"title":         types.StringType,
+		"description":   types.StringType,
+		"url":           types.StringType,
+		"color":         types.Int64Type,
+		"timestamp":     types.StringType,
+		"footer_text":   types.StringType,
+		"image_url":     types.StringType,
+		"thumbnail_url": types.StringType,
+		"author_name":   types.StringType,
+		"fields": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"name":   types.StringType,
+			"value":  types.StringType,
+			"inline": types.BoolType,
+		}}},
+	}
+}
+
+// messageAttachmentDataAttrTypes returns the attr.Type map for an attachment
+// object.
+func messageAttachmentDataAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"filename":     types.StringType,
+		"description":  types.StringType,
+		"content_type": types.StringType,
+		"size":         types.Int64Type,
+		"url":          types.StringType,
+	}
+}
+
+// messageDataAttrTypes returns the attr.Type map for a message object.
+func messageDataAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":               types.StringType,
+		"channel_id":       types.StringType,
+		"author_id":        types.StringType,
+		"author_username":  types.StringType,
+		"content":          types.StringType,
+		"timestamp":        types.StringType,
+		"edited_timestamp": types.StringType,
+		"pinned":           types.BoolType,
+		"type":             types.Int64Type,
+		"embeds":           types.ListType{ElemType: types.ObjectType{AttrTypes: messageEmbedDataAttrTypes()}},
+		"attachments":      types.ListType{ElemType: types.ObjectType{AttrTypes: messageAttachmentDataAttrTypes()}},
+	}
+}
+
+// messageDataAttributes returns the computed attributes of a message read by
+// the message data sources, without its ID and channel ID.
+func messageDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"author_id": schema.StringAttribute{
+			Description: "The ID of the user or webhook that wrote the message.",
+			Computed:    true,
+		},
+		"author_username": schema.StringAttribute{
+			Description: "The username of the author.",
+			Computed:    true,
+		},
+		"content": schema.StringAttribute{
+			Description: "The text of the message. Empty unless the bot has the MESSAGE_CONTENT intent, the " +
+				"message mentions the bot or the bot wrote it.",
+			Computed: true,
+		},
+		"timestamp": schema.StringAttribute{
+			Description: "When the message was sent, in RFC3339 format.",
+			Computed:    true,
+		},
+		"edited_timestamp": schema.StringAttribute{
+			Description: "When the message was last edited, in RFC3339 format. Null if it was never edited.",
+			Computed:    true,
+		},
+		"pinned": schema.BoolAttribute{
+			Description: "Whether the message is pinned.",
+			Computed:    true,
+		},
+		"type": schema.Int64Attribute{
+			Description: "The type of the message, such as 0 for a default message or 19 for a reply.",
+			Computed:    true,
+		},
+		"embeds": schema.ListNestedAttribute{
+			Description: "The embeds of the message, including link previews.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The type of the embed, such as rich, image or link.",
+						Computed:    true,
+					},
+					"title": schema.StringAttribute{
+						Description: "The title of the embed.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "The description of the embed.",
+						Computed:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "The URL of the title.",
+						Computed:    true,
+					},
+					"color": schema.Int64Attribute{
+						Description: "The color of the embed.",
+						Computed:    true,
+					},
+					"timestamp": schema.StringAttribute{
+						Description: "The timestamp shown in the footer, in RFC3339 format.",
+						Computed:    true,
+					},
+					"footer_text": schema.StringAttribute{
+						Description: "The footer text.",
+						Computed:    true,
+					},
+					"image_url": schema.StringAttribute{
+						Description: "The URL of the image.",
+						Computed:    true,
+					},
+					"thumbnail_url": schema.StringAttribute{
+						Description: "The URL of the thumbnail.",
+						Computed:    true,
+					},
+					"author_name": schema.StringAttribute{
+						Description: "The name of the embed author.",
+						Computed:    true,
+					},
+					"fields": schema.ListNestedAttribute{
+						Description: "The fields of the embed.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "The name of the field.",
+									Computed:    true,
+								},
+								"value": schema.StringAttribute{
+									Description: "The value of the field.",
+									Computed:    true,
+								},
+								"inline": schema.BoolAttribute{
+									Description: "Whether the field is displayed inline.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"attachments": schema.ListNestedAttribute{
+			Description: "The files attached to the message.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the attachment.",
+						Computed:    true,
+					},
+					"filename": schema.StringAttribute{
+						Description: "The name of the file.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "The description of the file.",
+						Computed:    true,
+					},
+					"content_type": schema.StringAttribute{
+						Description: "The media type of the file.",
+						Computed:    true,
+					},
+					"size": schema.Int64Attribute{
+						Description: "The size of the file in bytes.",
+						Computed:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "The URL of the file.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *messageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *messageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the data source.
+func (d *messageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := messageDataAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the message.",
+		Required:    true,
+	}
+	attributes["channel_id"] = schema.StringAttribute{
+		Description: "The ID of the channel the message is in.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read an existing Discord message, for example to reply to it or " +
+			"pin it, without importing it.",
+		Attributes: attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *messageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config messageDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	msg, err := d.client.GetChannelMessage(
+		ctx,
+		discord.Snowflake(config.ChannelID.ValueString()),
+		discord.Snowflake(config.ID.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Message",
+			"Could not read message "+config.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state := flattenMessageData(msg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// flattenMessageData converts a Discord message to the model of the message
+// data sources.
+func flattenMessageData(msg *discord.Message) messageDataModel {
+	m := messageDataModel{
+		ID:              types.StringValue(msg.ID.String()),
+		ChannelID:       types.StringValue(msg.ChannelID.String()),
+		AuthorID:        types.StringNull(),
+		AuthorUsername:  types.StringNull(),
+		Content:         types.StringValue(msg.Content),
+		Timestamp:       types.StringValue(msg.Timestamp.UTC().Format(time.RFC3339)),
+		EditedTimestamp: types.StringNull(),
+		Pinned:          types.BoolValue(msg.Pinned),
+		Type:            types.Int64Value(int64(msg.Type)),
+		Embeds:          []messageEmbedDataModel{},
+		Attachments:     []messageAttachmentDataModel{},
+	}
+	if msg.Author != nil {
+		m.AuthorID = types.StringValue(msg.Author.ID.String())
+		m.AuthorUsername = types.StringValue(msg.Author.Username)
+	}
+	if msg.EditedTimestamp != nil {
+		m.EditedTimestamp = types.StringValue(msg.EditedTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	for _, e := range msg.Embeds {
+		embed := messageEmbedDataModel{
+			Type:         stringValue(e.Type),
+			Title:        stringValue(e.Title),
+			Description:  stringValue(e.Description),
+			URL:          stringValue(e.URL),
+			Color:        types.Int64Null(),
+			Timestamp:    types.StringNull(),
+			FooterText:   types.StringNull(),
+			ImageURL:     types.StringNull(),
+			ThumbnailURL: types.StringNull(),
+			AuthorName:   types.StringNull(),
+			Fields:       []embedFieldModel{},
+		}
+		if e.Color != nil {
+			embed.Color = types.Int64Value(int64(*e.Color))
+		}
+		if e.Timestamp != nil {
+			embed.Timestamp = types.StringValue(e.Timestamp.UTC().Format(time.RFC3339))
+		}
+		if e.Footer != nil {
+			embed.FooterText = types.StringValue(e.Footer.Text)
+		}
+		if e.Image != nil {
+			embed.ImageURL = stringValue(e.Image.URL)
+		}
+		if e.Thumbnail != nil {
+			embed.ThumbnailURL = stringValue(e.Thumbnail.URL)
+		}
+		if e.Author != nil {
+			embed.AuthorName = stringValue(e.Author.Name)
+		}
+		for _, f := range e.Fields {
+			embed.Fields = append(embed.Fields, embedFieldModel{
+				Name:   types.StringValue(f.Name),
+				Value:  types.StringValue(f.Value),
+				Inline: types.BoolValue(f.Inline != nil && *f.Inline),
+			})
+		}
+		m.Embeds = append(m.Embeds, embed)
+	}
+
+	for _, a := range msg.Attachments {
+		m.Attachments = append(m.Attachments, messageAttachmentDataModel{
+			ID:          types.StringValue(a.ID.String()),
+			Filename:    types.StringValue(a.Filename),
+			Description: stringValue(a.Description),
+			ContentType: stringValue(a.ContentType),
+			Size:        types.Int64Value(int64(a.Size)),
+			URL:         types.StringValue(a.URL),
+		})
+	}
+	return m
+}
@@ -0,0 +1,65 @@
+package message_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMessageDataSource_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageDataSourceConfig_basic(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.discord_message.test", "author_id", "discord_message.test", "author_id"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "content", "Read me back"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "pinned", "true"),
+					resource.TestCheckResourceAttrSet("data.discord_message.test", "timestamp"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "embeds.#", "1"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "embeds.0.type", "rich"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "embeds.0.title", "Embedded"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "embeds.0.fields.0.value", "Value"),
+					resource.TestCheckResourceAttr("data.discord_message.test", "attachments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageDataSourceConfig_basic(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "msg_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-msg-test"
+  type     = 0
+}
+
+resource "discord_message" "test" {
+  channel_id = discord_channel.msg_test.id
+  content    = "Read me back"
+  pinned     = true
+
+  embed {
+    title = "Embedded"
+
+    field {
+      name  = "Name"
+      value = "Value"
+    }
+  }
+}
+
+data "discord_message" "test" {
+  channel_id = discord_message.test.channel_id
+  id         = discord_message.test.id
+}
+`, guildID)
+}
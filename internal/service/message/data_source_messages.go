@@ -0,0 +1,186 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxMessagesLimit is the most messages the messages data source reads, so
+// that a large limit cannot page through a whole channel history.
+const maxMessagesLimit = 1000
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &messagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &messagesDataSource{}
+)
+
+// messagesDataSource is the data source implementation.
+type messagesDataSource struct {
+	client *discord.Client
+}
+
+// messagesDataSourceModel maps the data source schema data.
+type messagesDataSourceModel struct {
+	ChannelID    types.String `tfsdk:"channel_id"`
+	Before       types.String `tfsdk:"before"`
+	After        types.String `tfsdk:"after"`
+	Around       types.String `tfsdk:"around"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	AuthorID     types.String `tfsdk:"author_id"`
+	ContentRegex types.String `tfsdk:"content_regex"`
+	Messages     types.List   `tfsdk:"messages"`
+}
+
+// NewMessagesDataSource returns a new messages data source.
+func NewMessagesDataSource() datasource.DataSource {
+	return &messagesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *messagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_messages"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *messagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the data source.
+func (d *messagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	messageAttributes := messageDataAttributes()
+	messageAttributes["id"] = schema.StringAttribute{
+		Description: "The ID of the message.",
+		Computed:    true,
+	}
+	messageAttributes["channel_id"] = schema.StringAttribute{
+		Description: "The ID of the channel the message is in.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read the history of a Discord channel, optionally filtered by " +
+			"author or content. Reading the history requires the Read Message History permission.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel to read messages from.",
+				Required:    true,
+			},
+			"before": schema.StringAttribute{
+				Description: "Only read messages sent before the message with this ID.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("after"), path.MatchRoot("around")),
+				},
+			},
+			"after": schema.StringAttribute{
+				Description: "Only read messages sent after the message with this ID.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("before"), path.MatchRoot("around")),
+				},
+			},
+			"around": schema.StringAttribute{
+				Description: fmt.Sprintf("Read the messages around the message with this ID, at most %d.",
+					discord.MaxMessagesPageSize),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("before"), path.MatchRoot("after")),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of messages to read, from 1 to %d. More than %d are read in "+
+					"pages. The author_id and content_regex filters apply to the messages read, so fewer may be "+
+					"returned. Defaults to 50.", maxMessagesLimit, discord.MaxMessagesPageSize),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxMessagesLimit),
+				},
+			},
+			"author_id": schema.StringAttribute{
+				Description: "Only return messages written by this user or webhook.",
+				Optional:    true,
+			},
+			"content_regex": schema.StringAttribute{
+				Description: "Only return messages whose content matches this regular expression.",
+				Optional:    true,
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "The matching messages, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: messageAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *messagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config messagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contentRegex *regexp.Regexp
+	if !config.ContentRegex.IsNull() {
+		var err error
+		contentRegex, err = regexp.Compile(config.ContentRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content_regex"),
+				"Invalid Regular Expression",
+				"Could not compile content_regex: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	messages, err := d.client.GetChannelMessages(ctx, discord.Snowflake(config.ChannelID.ValueString()), &discord.GetChannelMessagesParams{
+		Before: discord.Snowflake(config.Before.ValueString()),
+		After:  discord.Snowflake(config.After.ValueString()),
+		Around: discord.Snowflake(config.Around.ValueString()),
+		Limit:  int(config.Limit.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Messages",
+			"Could not read messages of channel "+config.ChannelID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	models := make([]messageDataModel, 0, len(messages))
+	for _, msg := range messages {
+		if !config.AuthorID.IsNull() && (msg.Author == nil || msg.Author.ID.String() != config.AuthorID.ValueString()) {
+			continue
+		}
+		if contentRegex != nil && !contentRegex.MatchString(msg.Content) {
+			continue
+		}
+		models = append(models, flattenMessageData(msg))
+	}
+
+	messagesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: messageDataAttrTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Messages = messagesList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
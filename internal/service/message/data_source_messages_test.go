@@ -0,0 +1,87 @@
+package message_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMessagesDataSource_filters(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessagesDataSourceConfig_filters(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discord_messages.all", "messages.#", "3"),
+					resource.TestCheckResourceAttrPair("data.discord_messages.all", "messages.0.id", "discord_message.third", "id"),
+					resource.TestCheckResourceAttr("data.discord_messages.by_content", "messages.#", "2"),
+					resource.TestCheckResourceAttr("data.discord_messages.by_content", "messages.1.content", "Release 1.0"),
+					resource.TestCheckResourceAttr("data.discord_messages.before", "messages.#", "1"),
+					resource.TestCheckResourceAttrPair("data.discord_messages.before", "messages.0.id", "discord_message.first", "id"),
+					resource.TestCheckResourceAttrPair("data.discord_messages.by_author", "messages.#", "data.discord_messages.all", "messages.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessagesDataSourceConfig_filters(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "msg_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-msg-test"
+  type     = 0
+}
+
+resource "discord_message" "first" {
+  channel_id = discord_channel.msg_test.id
+  content    = "Release 1.0"
+}
+
+resource "discord_message" "second" {
+  channel_id = discord_channel.msg_test.id
+  content    = "Unrelated chatter"
+
+  depends_on = [discord_message.first]
+}
+
+resource "discord_message" "third" {
+  channel_id = discord_channel.msg_test.id
+  content    = "Release 1.1"
+
+  depends_on = [discord_message.second]
+}
+
+data "discord_messages" "all" {
+  channel_id = discord_channel.msg_test.id
+
+  depends_on = [discord_message.third]
+}
+
+data "discord_messages" "by_content" {
+  channel_id    = discord_channel.msg_test.id
+  content_regex = "^Release "
+
+  depends_on = [discord_message.third]
+}
+
+data "discord_messages" "before" {
+  channel_id = discord_channel.msg_test.id
+  before     = discord_message.second.id
+}
+
+data "discord_messages" "by_author" {
+  channel_id = discord_channel.msg_test.id
+  author_id  = discord_message.first.author_id
+
+  depends_on = [discord_message.third]
+}
+`, guildID)
+}
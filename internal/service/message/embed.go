@@ -0,0 +1,365 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Discord's embed limits, counted in characters.
+const (
+	maxEmbeds                = 10
+	maxEmbedFields           = 25
+	maxEmbedTitleLength      = 256
+	maxEmbedDescriptionLen   = 4096
+	maxEmbedFieldNameLength  = 256
+	maxEmbedFieldValueLength = 1024
+	maxEmbedFooterTextLength = 2048
+	maxEmbedAuthorNameLength = 256
+	maxEmbedTotalLength      = 6000
+)
+
+// embedModel maps the embed block schema data.
+type embedModel struct {
+	Title         types.String      `tfsdk:"title"`
+	Description   types.String      `tfsdk:"description"`
+	URL           types.String      `tfsdk:"url"`
+	Color         types.Int64       `tfsdk:"color"`
+	Timestamp     types.String      `tfsdk:"timestamp"`
+	FooterText    types.String      `tfsdk:"footer_text"`
+	FooterIconURL types.String      `tfsdk:"footer_icon_url"`
+	ImageURL      types.String      `tfsdk:"image_url"`
+	ThumbnailURL  types.String      `tfsdk:"thumbnail_url"`
+	AuthorName    types.String      `tfsdk:"author_name"`
+	AuthorURL     types.String      `tfsdk:"author_url"`
+	AuthorIconURL types.String      `tfsdk:"author_icon_url"`
+	Field         []embedFieldModel `tfsdk:"field"`
+}
+
+// embedFieldModel maps the embed field block schema data.
+type embedFieldModel struct {
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+	Inline types.Bool   `tfsdk:"inline"`
+}
+
+// embedBlock returns the schema for the embed block.
+func embedBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("Embedded rich content, at most %d per message. The combined text of all embeds "+
+			"may not exceed %d characters.", maxEmbeds, maxEmbedTotalLength),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(maxEmbeds),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"title": schema.StringAttribute{
+					Description: fmt.Sprintf("Title of the embed (up to %d characters).", maxEmbedTitleLength),
+					Optional:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: fmt.Sprintf("Description of the embed (up to %d characters).", maxEmbedDescriptionLen),
+					Optional:    true,
+				},
+				"url": schema.StringAttribute{
+					Description: "URL of the embed.",
+					Optional:    true,
+				},
+				"color": schema.Int64Attribute{
+					Description: "Color code of the embed.",
+					Optional:    true,
+				},
+				"timestamp": schema.StringAttribute{
+					Description: "Timestamp shown in the embed footer, in RFC3339 format.",
+					Optional:    true,
+				},
+				"footer_text": schema.StringAttribute{
+					Description: fmt.Sprintf("Footer text of the embed (up to %d characters).", maxEmbedFooterTextLength),
+					Optional:    true,
+				},
+				"footer_icon_url": schema.StringAttribute{
+					Description: "URL of the footer icon. Requires footer_text.",
+					Optional:    true,
+				},
+				"image_url": schema.StringAttribute{
+					Description: "Image URL of the embed.",
+					Optional:    true,
+				},
+				"thumbnail_url": schema.StringAttribute{
+					Description: "Thumbnail URL of the embed.",
+					Optional:    true,
+				},
+				"author_name": schema.StringAttribute{
+					Description: fmt.Sprintf("Name of the embed author (up to %d characters).", maxEmbedAuthorNameLength),
+					Optional:    true,
+				},
+				"author_url": schema.StringAttribute{
+					Description: "URL of the embed author. Requires author_name.",
+					Optional:    true,
+				},
+				"author_icon_url": schema.StringAttribute{
+					Description: "URL of the embed author icon. Requires author_name.",
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"field": schema.ListNestedBlock{
+					Description: fmt.Sprintf("A field of the embed, at most %d per embed.", maxEmbedFields),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(maxEmbedFields),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Description: fmt.Sprintf("Name of the field (up to %d characters).", maxEmbedFieldNameLength),
+								Required:    true,
+							},
+							"value": schema.StringAttribute{
+								Description: fmt.Sprintf("Value of the field (up to %d characters).", maxEmbedFieldValueLength),
+								Required:    true,
+							},
+							"inline": schema.BoolAttribute{
+								Description: "Whether the field is displayed inline. Defaults to `false`.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateEmbedsConfig checks the embed blocks in a configuration against
+// Discord's embed limits. Unknown values are skipped.
+func validateEmbedsConfig(ctx context.Context, config tfsdk.Config, embedsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var list types.List
+	diags.Append(config.GetAttribute(ctx, embedsPath, &list)...)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return diags
+	}
+
+	var embeds []embedModel
+	if d := list.ElementsAs(ctx, &embeds, false); d.HasError() {
+		// Partially unknown blocks are validated again once known.
+		return diags
+	}
+
+	total := 0
+	check := func(value types.String, p path.Path, limit int) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		n := utf8.RuneCountInString(value.ValueString())
+		total += n
+		if n > limit {
+			diags.AddAttributeError(p, "Embed Limit Exceeded",
+				fmt.Sprintf("Discord allows at most %d characters here, got %d.", limit, n))
+		}
+	}
+
+	for i, e := range embeds {
+		p := embedsPath.AtListIndex(i)
+		check(e.Title, p.AtName("title"), maxEmbedTitleLength)
+		check(e.Description, p.AtName("description"), maxEmbedDescriptionLen)
+		check(e.FooterText, p.AtName("footer_text"), maxEmbedFooterTextLength)
+		check(e.AuthorName, p.AtName("author_name"), maxEmbedAuthorNameLength)
+		for j, f := range e.Field {
+			fp := p.AtName("field").AtListIndex(j)
+			check(f.Name, fp.AtName("name"), maxEmbedFieldNameLength)
+			check(f.Value, fp.AtName("value"), maxEmbedFieldValueLength)
+		}
+
+		if !e.Timestamp.IsNull() && !e.Timestamp.IsUnknown() {
+			if _, err := time.Parse(time.RFC3339, e.Timestamp.ValueString()); err != nil {
+				diags.AddAttributeError(p.AtName("timestamp"), "Invalid Timestamp",
+					"timestamp must be in RFC3339 format (e.g. 2025-01-02T15:04:05Z): "+err.Error())
+			}
+		}
+		if e.FooterText.IsNull() && !e.FooterIconURL.IsNull() {
+			diags.AddAttributeError(p.AtName("footer_icon_url"), "Missing Footer Text",
+				"footer_icon_url requires footer_text to be set.")
+		}
+		if e.AuthorName.IsNull() && (!e.AuthorURL.IsNull() || !e.AuthorIconURL.IsNull()) {
+			diags.AddAttributeError(p.AtName("author_name"), "Missing Author Name",
+				"author_url and author_icon_url require author_name to be set.")
+		}
+	}
+
+	if total > maxEmbedTotalLength {
+		diags.AddAttributeError(embedsPath, "Embed Limit Exceeded",
+			fmt.Sprintf("The combined text of all embeds may not exceed %d characters, got %d.", maxEmbedTotalLength, total))
+	}
+	return diags
+}
+
+// buildEmbeds converts the embed models to Discord API embed objects.
+func buildEmbeds(embeds []embedModel) []*discord.Embed {
+	if len(embeds) == 0 {
+		return nil
+	}
+	result := make([]*discord.Embed, 0, len(embeds))
+	for _, e := range embeds {
+		embed := &discord.Embed{}
+		if !e.Title.IsNull() && !e.Title.IsUnknown() {
+			t := e.Title.ValueString()
+			embed.Title = &t
+		}
+		if !e.Description.IsNull() && !e.Description.IsUnknown() {
+			d := e.Description.ValueString()
+			embed.Description = &d
+		}
+		if !e.URL.IsNull() && !e.URL.IsUnknown() {
+			u := e.URL.ValueString()
+			embed.URL = &u
+		}
+		if !e.Color.IsNull() && !e.Color.IsUnknown() {
+			c := int(e.Color.ValueInt64())
+			embed.Color = &c
+		}
+		if !e.Timestamp.IsNull() && !e.Timestamp.IsUnknown() {
+			if ts, err := time.Parse(time.RFC3339, e.Timestamp.ValueString()); err == nil {
+				embed.Timestamp = &ts
+			}
+		}
+		if !e.FooterText.IsNull() && !e.FooterText.IsUnknown() {
+			embed.Footer = &discord.EmbedFooter{
+				Text: e.FooterText.ValueString(),
+			}
+			if !e.FooterIconURL.IsNull() && !e.FooterIconURL.IsUnknown() {
+				u := e.FooterIconURL.ValueString()
+				embed.Footer.IconURL = &u
+			}
+		}
+		if !e.ImageURL.IsNull() && !e.ImageURL.IsUnknown() {
+			u := e.ImageURL.ValueString()
+			embed.Image = &discord.EmbedImage{
+				URL: &u,
+			}
+		}
+		if !e.ThumbnailURL.IsNull() && !e.ThumbnailURL.IsUnknown() {
+			u := e.ThumbnailURL.ValueString()
+			embed.Thumbnail = &discord.EmbedImage{
+				URL: &u,
+			}
+		}
+		if !e.AuthorName.IsNull() && !e.AuthorName.IsUnknown() {
+			n := e.AuthorName.ValueString()
+			embed.Author = &discord.EmbedAuthor{
+				Name: &n,
+			}
+			if !e.AuthorURL.IsNull() && !e.AuthorURL.IsUnknown() {
+				u := e.AuthorURL.ValueString()
+				embed.Author.URL = &u
+			}
+			if !e.AuthorIconURL.IsNull() && !e.AuthorIconURL.IsUnknown() {
+				u := e.AuthorIconURL.ValueString()
+				embed.Author.IconURL = &u
+			}
+		}
+		for _, f := range e.Field {
+			inline := f.Inline.ValueBool()
+			embed.Fields = append(embed.Fields, &discord.EmbedField{
+				Name:   f.Name.ValueString(),
+				Value:  f.Value.ValueString(),
+				Inline: &inline,
+			})
+		}
+		result = append(result, embed)
+	}
+	return result
+}
+
+// flattenEmbeds converts Discord API embed objects to embed models, skipping
+// the link previews Discord adds on its own. A timestamp from prior is kept
+// when it names the same instant as the one Discord returns, so equivalent
+// RFC3339 spellings do not show as drift.
+func flattenEmbeds(embeds []*discord.Embed, prior []embedModel) []embedModel {
+	if len(embeds) == 0 {
+		return nil
+	}
+	result := make([]embedModel, 0, len(embeds))
+	for _, e := range embeds {
+		if e.Type != nil && *e.Type != "rich" {
+			// Link previews are generated by Discord rather than configured.
+			continue
+		}
+		i := len(result)
+		m := embedModel{
+			Title:         stringValue(e.Title),
+			Description:   stringValue(e.Description),
+			URL:           stringValue(e.URL),
+			Timestamp:     types.StringNull(),
+			FooterText:    types.StringNull(),
+			FooterIconURL: types.StringNull(),
+			ImageURL:      types.StringNull(),
+			ThumbnailURL:  types.StringNull(),
+			AuthorName:    types.StringNull(),
+			AuthorURL:     types.StringNull(),
+			AuthorIconURL: types.StringNull(),
+		}
+		if e.Color != nil {
+			m.Color = types.Int64Value(int64(*e.Color))
+		} else {
+			m.Color = types.Int64Null()
+		}
+		if e.Timestamp != nil {
+			m.Timestamp = types.StringValue(e.Timestamp.UTC().Format(time.RFC3339))
+			if i < len(prior) && !prior[i].Timestamp.IsNull() && !prior[i].Timestamp.IsUnknown() {
+				if ts, err := time.Parse(time.RFC3339, prior[i].Timestamp.ValueString()); err == nil && ts.Equal(*e.Timestamp) {
+					m.Timestamp = prior[i].Timestamp
+				}
+			}
+		}
+		if e.Footer != nil {
+			m.FooterText = types.StringValue(e.Footer.Text)
+			m.FooterIconURL = stringValue(e.Footer.IconURL)
+		}
+		if e.Image != nil {
+			m.ImageURL = stringValue(e.Image.URL)
+		}
+		if e.Thumbnail != nil {
+			m.ThumbnailURL = stringValue(e.Thumbnail.URL)
+		}
+		if e.Author != nil {
+			m.AuthorName = stringValue(e.Author.Name)
+			m.AuthorURL = stringValue(e.Author.URL)
+			m.AuthorIconURL = stringValue(e.Author.IconURL)
+		}
+		for _, f := range e.Fields {
+			m.Field = append(m.Field, embedFieldModel{
+				Name:   types.StringValue(f.Name),
+				Value:  types.StringValue(f.Value),
+				Inline: types.BoolValue(f.Inline != nil && *f.Inline),
+			})
+		}
+		result = append(result, m)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// stringValue converts an optional string to a string value.
+func stringValue(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
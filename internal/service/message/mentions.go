@@ -0,0 +1,133 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxAllowedMentionIDs is the most role or user IDs allowed_mentions accepts.
+const maxAllowedMentionIDs = 100
+
+// allowedMentionsModel maps the allowed_mentions block schema data.
+type allowedMentionsModel struct {
+	Parse       types.Set  `tfsdk:"parse"`
+	Roles       types.Set  `tfsdk:"roles"`
+	Users       types.Set  `tfsdk:"users"`
+	RepliedUser types.Bool `tfsdk:"replied_user"`
+}
+
+// allowedMentionsBlock returns the schema for the allowed_mentions block.
+func allowedMentionsBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Controls which mentions in the message notify their targets. Without this block Discord " +
+			"notifies every mention in the content; with an empty block no one is notified.",
+		Attributes: map[string]schema.Attribute{
+			"parse": schema.SetAttribute{
+				Description: "Mention types parsed from the content: `roles`, `users` and `everyone` " +
+					"(@everyone and @here).",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						discord.AllowedMentionRoles, discord.AllowedMentionUsers, discord.AllowedMentionEveryone,
+					)),
+				},
+			},
+			"roles": schema.SetAttribute{
+				Description: fmt.Sprintf("IDs of the roles that may be notified (up to %d). Conflicts with "+
+					"`roles` in `parse`.", maxAllowedMentionIDs),
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(maxAllowedMentionIDs),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description: fmt.Sprintf("IDs of the users that may be notified (up to %d). Conflicts with "+
+					"`users` in `parse`.", maxAllowedMentionIDs),
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(maxAllowedMentionIDs),
+				},
+			},
+			"replied_user": schema.BoolAttribute{
+				Description: "Whether the author of a replied-to message is notified.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// validateAllowedMentionsConfig reports mention types that are both parsed
+// and listed explicitly, which Discord rejects.
+func validateAllowedMentionsConfig(ctx context.Context, config tfsdk.Config, mentionsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var mentions *allowedMentionsModel
+	if d := config.GetAttribute(ctx, mentionsPath, &mentions); d.HasError() || mentions == nil {
+		// Unknown values are validated again once known.
+		return diags
+	}
+	if mentions.Parse.IsNull() || mentions.Parse.IsUnknown() {
+		return diags
+	}
+
+	var parse []string
+	if d := mentions.Parse.ElementsAs(ctx, &parse, false); d.HasError() {
+		return diags
+	}
+	for _, t := range parse {
+		switch {
+		case t == discord.AllowedMentionRoles && !mentions.Roles.IsNull():
+			diags.AddAttributeError(mentionsPath.AtName("roles"), "Conflicting Allowed Mentions",
+				"roles cannot be set when parse contains \"roles\".")
+		case t == discord.AllowedMentionUsers && !mentions.Users.IsNull():
+			diags.AddAttributeError(mentionsPath.AtName("users"), "Conflicting Allowed Mentions",
+				"users cannot be set when parse contains \"users\".")
+		}
+	}
+	return diags
+}
+
+// buildAllowedMentions converts the allowed_mentions block to its Discord
+// API object, or nil when the block is not set.
+func buildAllowedMentions(ctx context.Context, m *allowedMentionsModel) (*discord.AllowedMentions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if m == nil {
+		return nil, diags
+	}
+
+	result := &discord.AllowedMentions{
+		Parse:       []string{},
+		RepliedUser: boolPointer(m.RepliedUser),
+	}
+	if !m.Parse.IsNull() {
+		diags.Append(m.Parse.ElementsAs(ctx, &result.Parse, false)...)
+	}
+
+	var roles, users []string
+	if !m.Roles.IsNull() {
+		diags.Append(m.Roles.ElementsAs(ctx, &roles, false)...)
+	}
+	if !m.Users.IsNull() {
+		diags.Append(m.Users.ElementsAs(ctx, &users, false)...)
+	}
+	for _, id := range roles {
+		result.Roles = append(result.Roles, discord.Snowflake(id))
+	}
+	for _, id := range users {
+		result.Users = append(result.Users, discord.Snowflake(id))
+	}
+	return result, diags
+}
@@ -0,0 +1,304 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Discord's poll limits. The duration is in hours.
+const (
+	maxPollQuestionLength = 300
+	maxPollAnswerLength   = 55
+	maxPollAnswers        = 10
+	maxPollDuration       = 768
+	defaultPollDuration   = 24
+)
+
+// pollLayouts maps the names accepted by the layout attribute to Discord's
+// poll layout types.
+var pollLayouts = map[string]int{
+	"default": discord.PollLayoutDefault,
+}
+
+// pollModel maps the poll block schema data.
+type pollModel struct {
+	Question         types.String      `tfsdk:"question"`
+	Duration         types.Int64       `tfsdk:"duration"`
+	AllowMultiselect types.Bool        `tfsdk:"allow_multiselect"`
+	Layout           types.String      `tfsdk:"layout"`
+	Expire           types.Bool        `tfsdk:"expire"`
+	ExpiresAt        types.String      `tfsdk:"expires_at"`
+	Finalized        types.Bool        `tfsdk:"finalized"`
+	Answer           []pollAnswerModel `tfsdk:"answer"`
+}
+
+// pollAnswerModel maps the answer block schema data.
+type pollAnswerModel struct {
+	Text      types.String `tfsdk:"text"`
+	Emoji     types.String `tfsdk:"emoji"`
+	AnswerID  types.Int64  `tfsdk:"answer_id"`
+	VoteCount types.Int64  `tfsdk:"vote_count"`
+}
+
+// pollBlock returns the schema for the poll block.
+func pollBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "A poll attached to the message. Discord does not allow polls to be edited, so changing " +
+			"anything but `expire` forces a new message.",
+		Attributes: map[string]schema.Attribute{
+			"question": schema.StringAttribute{
+				Description: fmt.Sprintf("The question of the poll (up to %d characters). Required.",
+					maxPollQuestionLength),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxPollQuestionLength),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of hours the poll is open for, from 1 to %d. Defaults to %d.",
+					maxPollDuration, defaultPollDuration),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxPollDuration),
+				},
+			},
+			"allow_multiselect": schema.BoolAttribute{
+				Description: "Whether voters can select more than one answer. Defaults to `false`.",
+				Optional:    true,
+			},
+			"layout": schema.StringAttribute{
+				Description: "The layout of the poll. Only `default` is supported.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("default"),
+				},
+			},
+			"expire": schema.BoolAttribute{
+				Description: "Set to `true` to end the poll before its duration is up. An ended poll cannot be " +
+					"reopened, so setting this back to `false` has no effect. A failure to end the poll when the " +
+					"message is created is reported as a warning and retried on the next apply.",
+				Optional: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "When the poll closes, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finalized": schema.BoolAttribute{
+				Description: "Whether the poll has closed and its vote counts are final.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"answer": schema.ListNestedBlock{
+				Description: fmt.Sprintf("An answer of the poll, at least 1 and at most %d.", maxPollAnswers),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(maxPollAnswers),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Description: fmt.Sprintf("The text of the answer (up to %d characters).", maxPollAnswerLength),
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, maxPollAnswerLength),
+							},
+						},
+						"emoji": schema.StringAttribute{
+							Description: "A unicode emoji, or the ID of a custom emoji, shown with the answer.",
+							Optional:    true,
+						},
+						"answer_id": schema.Int64Attribute{
+							Description: "The ID Discord assigned to the answer.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"vote_count": schema.Int64Attribute{
+							Description: "The number of votes for the answer when the message was last read.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validatePollConfig checks that a poll block in a configuration has a
+// question and an answer. The question is optional in the schema, since the
+// framework validates required attributes of a single block even when the
+// block is absent, and it turns an empty list of blocks into null, which the
+// answer block's size validators skip.
+func validatePollConfig(ctx context.Context, config tfsdk.Config, pollPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var poll types.Object
+	diags.Append(config.GetAttribute(ctx, pollPath, &poll)...)
+	if diags.HasError() || poll.IsNull() || poll.IsUnknown() {
+		return diags
+	}
+
+	var question types.String
+	diags.Append(config.GetAttribute(ctx, pollPath.AtName("question"), &question)...)
+	if !diags.HasError() && question.IsNull() {
+		diags.AddAttributeError(pollPath.AtName("question"), "Missing Poll Question",
+			"A poll must have a question.")
+	}
+
+	var answers types.List
+	diags.Append(config.GetAttribute(ctx, pollPath.AtName("answer"), &answers)...)
+	if !diags.HasError() && answers.IsNull() {
+		diags.AddAttributeError(pollPath, "Missing Poll Answer", "A poll must have at least one answer block.")
+	}
+	return diags
+}
+
+// buildPoll converts the poll block to its Discord API object, or nil when
+// the block is not set.
+func buildPoll(p *pollModel) *discord.PollCreateRequest {
+	if p == nil {
+		return nil
+	}
+	result := &discord.PollCreateRequest{
+		Question:         discord.PollMedia{Text: stringPointer(p.Question)},
+		Answers:          make([]*discord.PollAnswer, 0, len(p.Answer)),
+		Duration:         intPointer(p.Duration),
+		AllowMultiselect: boolPointer(p.AllowMultiselect),
+	}
+	if layout, ok := pollLayouts[p.Layout.ValueString()]; ok {
+		result.LayoutType = &layout
+	}
+	for _, a := range p.Answer {
+		result.Answers = append(result.Answers, &discord.PollAnswer{
+			PollMedia: discord.PollMedia{
+				Text:  stringPointer(a.Text),
+				Emoji: buildComponentEmoji(a.Emoji),
+			},
+		})
+	}
+	return result
+}
+
+// flattenPoll converts the poll of a message to a poll model. Attributes
+// left unset in prior stay null when Discord reports their default. Discord
+// does not return the duration, so it is kept from prior, or worked out from
+// the expiry when the message was imported. Ending a poll early moves its
+// expiry, so the duration is not worked out again afterwards.
+func flattenPoll(msg *discord.Message, prior *pollModel) *pollModel {
+	p := msg.Poll
+	if p == nil {
+		return nil
+	}
+	imported := prior == nil
+	if imported {
+		prior = &pollModel{
+			Duration:         types.Int64Null(),
+			AllowMultiselect: types.BoolNull(),
+			Layout:           types.StringNull(),
+			Expire:           types.BoolNull(),
+		}
+	}
+
+	m := &pollModel{
+		Question:         stringValue(p.Question.Text),
+		Duration:         prior.Duration,
+		AllowMultiselect: flattenBool(&p.AllowMultiselect, false, prior.AllowMultiselect),
+		Layout:           types.StringNull(),
+		Expire:           prior.Expire,
+		ExpiresAt:        types.StringNull(),
+		Finalized:        types.BoolValue(p.Results != nil && p.Results.IsFinalized),
+	}
+	if p.Expiry != nil {
+		m.ExpiresAt = types.StringValue(p.Expiry.UTC().Format(time.RFC3339))
+		if imported {
+			hours := int(math.Round(p.Expiry.Sub(msg.Timestamp).Hours()))
+			m.Duration = flattenInt(&hours, defaultPollDuration, types.Int64Null())
+		}
+	}
+	if p.LayoutType != discord.PollLayoutDefault || !prior.Layout.IsNull() {
+		for name, layout := range pollLayouts {
+			if p.LayoutType == layout {
+				m.Layout = types.StringValue(name)
+			}
+		}
+	}
+
+	votes := map[int]int{}
+	if p.Results != nil {
+		for _, c := range p.Results.AnswerCounts {
+			votes[c.ID] = c.Count
+		}
+	}
+	for _, a := range p.Answers {
+		m.Answer = append(m.Answer, pollAnswerModel{
+			Text:      stringValue(a.PollMedia.Text),
+			Emoji:     flattenComponentEmoji(a.PollMedia.Emoji),
+			AnswerID:  types.Int64Value(int64(a.AnswerID)),
+			VoteCount: types.Int64Value(int64(votes[a.AnswerID])),
+		})
+	}
+	return m
+}
+
+// pollReplaced reports whether the planned poll differs from the poll in
+// state in a way that needs a new message.
+func pollReplaced(planned, current *pollModel) bool {
+	if planned == nil || current == nil {
+		return (planned == nil) != (current == nil)
+	}
+	if !planned.Question.Equal(current.Question) ||
+		!planned.Duration.Equal(current.Duration) ||
+		!planned.AllowMultiselect.Equal(current.AllowMultiselect) ||
+		!planned.Layout.Equal(current.Layout) ||
+		len(planned.Answer) != len(current.Answer) {
+		return true
+	}
+	for i := range planned.Answer {
+		if !planned.Answer[i].Text.Equal(current.Answer[i].Text) ||
+			!planned.Answer[i].Emoji.Equal(current.Answer[i].Emoji) {
+			return true
+		}
+	}
+	return false
+}
+
+// pollEnded reports whether a poll has closed, either because its results
+// are final or because its expiry has passed.
+func pollEnded(p *pollModel) bool {
+	if p.Finalized.ValueBool() {
+		return true
+	}
+	if p.ExpiresAt.IsNull() || p.ExpiresAt.IsUnknown() {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, p.ExpiresAt.ValueString())
+	return err == nil && !expiry.After(time.Now())
+}
@@ -0,0 +1,127 @@
+package message
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ---------- TestFlattenPoll_Duration ----------
+
+func TestFlattenPoll_Duration(t *testing.T) {
+	t.Parallel()
+
+	sent := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	question := "Q?"
+	// The poll was ended early, so it expires 2 hours after it was sent
+	// rather than after the default 24.
+	msg := &discord.Message{
+		Timestamp: sent,
+		Poll: &discord.Poll{
+			Question:   discord.PollMedia{Text: &question},
+			Expiry:     func() *time.Time { e := sent.Add(2 * time.Hour); return &e }(),
+			LayoutType: discord.PollLayoutDefault,
+			Results:    &discord.PollResults{IsFinalized: true},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		prior    *pollModel
+		expected types.Int64
+	}{
+		{name: "import", prior: nil, expected: types.Int64Value(2)},
+		{name: "unset", prior: &pollModel{Duration: types.Int64Null()}, expected: types.Int64Null()},
+		{name: "set", prior: &pollModel{Duration: types.Int64Value(48)}, expected: types.Int64Value(48)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := flattenPoll(msg, tc.prior)
+			if !p.Duration.Equal(tc.expected) {
+				t.Errorf("expected duration %s, got %s", tc.expected, p.Duration)
+			}
+			if !p.Finalized.ValueBool() {
+				t.Error("expected the poll to be finalized")
+			}
+		})
+	}
+}
+
+// ---------- TestValidatePollConfig ----------
+
+func TestValidatePollConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewMessageResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	pollType := objectType.AttributeTypes["poll"].(tftypes.Object)
+	answersType := pollType.AttributeTypes["answer"].(tftypes.List)
+	answerType := answersType.ElementType.(tftypes.Object)
+
+	// config returns a message configuration with the given poll attributes,
+	// or without a poll when poll is nil.
+	config := func(poll map[string]tftypes.Value) tfsdk.Config {
+		values := map[string]tftypes.Value{}
+		for name, typ := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		if poll != nil {
+			for name, typ := range pollType.AttributeTypes {
+				if _, ok := poll[name]; !ok {
+					poll[name] = tftypes.NewValue(typ, nil)
+				}
+			}
+			values["poll"] = tftypes.NewValue(pollType, poll)
+		}
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+	answers := tftypes.NewValue(answersType, []tftypes.Value{
+		tftypes.NewValue(answerType, map[string]tftypes.Value{
+			"text":       tftypes.NewValue(tftypes.String, "Yes"),
+			"emoji":      tftypes.NewValue(tftypes.String, nil),
+			"answer_id":  tftypes.NewValue(tftypes.Number, nil),
+			"vote_count": tftypes.NewValue(tftypes.Number, nil),
+		}),
+	})
+	question := tftypes.NewValue(tftypes.String, "Q?")
+
+	tests := []struct {
+		name          string
+		poll          map[string]tftypes.Value
+		expectedError string
+	}{
+		{name: "no poll"},
+		{name: "valid", poll: map[string]tftypes.Value{"question": question, "answer": answers}},
+		{name: "missing question", poll: map[string]tftypes.Value{"answer": answers}, expectedError: "Missing Poll Question"},
+		{name: "missing answer", poll: map[string]tftypes.Value{"question": question}, expectedError: "Missing Poll Answer"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validatePollConfig(ctx, config(tc.poll), path.Root("poll"))
+			if tc.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if len(diags.Errors()) != 1 || diags.Errors()[0].Summary() != tc.expectedError {
+				t.Errorf("expected error %q, got %v", tc.expectedError, diags)
+			}
+		})
+	}
+}
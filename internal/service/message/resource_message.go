@@ -2,24 +2,33 @@ package message
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
 	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &messageResource{}
-	_ resource.ResourceWithConfigure   = &messageResource{}
-	_ resource.ResourceWithImportState = &messageResource{}
+	_ resource.Resource                   = &messageResource{}
+	_ resource.ResourceWithConfigure      = &messageResource{}
+	_ resource.ResourceWithImportState    = &messageResource{}
+	_ resource.ResourceWithValidateConfig = &messageResource{}
+	_ resource.ResourceWithModifyPlan     = &messageResource{}
 )
 
 // messageResource is the resource implementation.
@@ -27,24 +36,30 @@ type messageResource struct {
 	client *discord.Client
 }
 
-// embedModel maps the embed block schema data.
-type embedModel struct {
-	Title        types.String `tfsdk:"title"`
-	Description  types.String `tfsdk:"description"`
-	URL          types.String `tfsdk:"url"`
-	Color        types.Int64  `tfsdk:"color"`
-	FooterText   types.String `tfsdk:"footer_text"`
-	ImageURL     types.String `tfsdk:"image_url"`
-	ThumbnailURL types.String `tfsdk:"thumbnail_url"`
-}
-
 // messageModel maps the resource schema data.
 type messageModel struct {
-	ID        types.String `tfsdk:"id"`
-	ChannelID types.String `tfsdk:"channel_id"`
-	Content   types.String `tfsdk:"content"`
-	TTS       types.Bool   `tfsdk:"tts"`
-	Embed     []embedModel `tfsdk:"embed"`
+	ID              types.String          `tfsdk:"id"`
+	ChannelID       types.String          `tfsdk:"channel_id"`
+	Content         types.String          `tfsdk:"content"`
+	TTS             types.Bool            `tfsdk:"tts"`
+	Pinned          types.Bool            `tfsdk:"pinned"`
+	Publish         types.Bool            `tfsdk:"publish"`
+	Crossposted     types.Bool            `tfsdk:"crossposted"`
+	AuthorID        types.String          `tfsdk:"author_id"`
+	ComponentsV2    types.Bool            `tfsdk:"components_v2"`
+	Embed           []embedModel          `tfsdk:"embed"`
+	Poll            *pollModel            `tfsdk:"poll"`
+	Component       []componentModel      `tfsdk:"component"`
+	Flags           types.Set             `tfsdk:"flags"`
+	Attachment      []attachmentModel     `tfsdk:"attachment"`
+	AllowedMentions *allowedMentionsModel `tfsdk:"allowed_mentions"`
+}
+
+// messageFlags maps the flag names accepted by the flags attribute to their
+// message flag bits.
+var messageFlags = map[string]int{
+	"suppress_embeds":        discord.MessageFlagSuppressEmbeds,
+	"suppress_notifications": discord.MessageFlagSuppressNotifications,
 }
 
 // NewMessageResource is a helper function to simplify the provider implementation.
@@ -60,7 +75,10 @@ func (r *messageResource) Metadata(_ context.Context, req resource.MetadataReque
 // Schema defines the schema for the resource.
 func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Discord message in a channel.",
+		Description: "Manages a Discord message in a channel. Edits made to the message outside of Terraform " +
+			"show up as drift and are reverted on apply. Messages written by other accounts can be imported but " +
+			"are read-only: only `pinned` and `publish` can change, and destroying the resource leaves the message " +
+			"in place.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the message.",
@@ -69,6 +87,13 @@ func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"author_id": schema.StringAttribute{
+				Description: "The ID of the user that wrote the message.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"channel_id": schema.StringAttribute{
 				Description: "The ID of the channel to send the message in.",
 				Required:    true,
@@ -89,44 +114,63 @@ func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					boolRequiresReplace{},
 				},
 			},
-		},
-		Blocks: map[string]schema.Block{
-			"embed": schema.ListNestedBlock{
-				Description: "Embedded rich content.",
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"title": schema.StringAttribute{
-							Description: "Title of the embed.",
-							Optional:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "Description of the embed.",
-							Optional:    true,
-						},
-						"url": schema.StringAttribute{
-							Description: "URL of the embed.",
-							Optional:    true,
-						},
-						"color": schema.Int64Attribute{
-							Description: "Color code of the embed.",
-							Optional:    true,
-						},
-						"footer_text": schema.StringAttribute{
-							Description: "Footer text of the embed.",
-							Optional:    true,
-						},
-						"image_url": schema.StringAttribute{
-							Description: "Image URL of the embed.",
-							Optional:    true,
-						},
-						"thumbnail_url": schema.StringAttribute{
-							Description: "Thumbnail URL of the embed.",
-							Optional:    true,
-						},
-					},
+			"pinned": schema.BoolAttribute{
+				Description: "Whether the message is pinned in the channel. When not set, the message is not pinned " +
+					"on creation and pins made outside of Terraform are left alone. A failure to pin a new message " +
+					"is reported as a warning and retried on the next apply.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Description: "Whether to publish the message to the channels following its announcement channel. " +
+					"Discord allows a few publishes per channel each hour. A published message cannot be unpublished, " +
+					"and later edits reach the following channels automatically. Only messages in announcement " +
+					"channels can be published. A failed publish is reported as a warning and retried on the next " +
+					"apply. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"crossposted": schema.BoolAttribute{
+				Description: "Whether the message has been published to the channels following its channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"components_v2": schema.BoolAttribute{
+				Description: "Whether the message uses Discord's layout components (the IS_COMPONENTS_V2 flag). Enables " +
+					"`container`, `section`, `text_display` and `separator` components, but such messages cannot have " +
+					"`content` or `embed` blocks. Discord does not allow the flag to be removed, so changing this " +
+					"forces a new message. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolRequiresReplace{},
+				},
+			},
+			"flags": schema.SetAttribute{
+				Description: "Message flags to set: `suppress_embeds` hides link previews and " +
+					"`suppress_notifications` sends the message silently. Discord only applies " +
+					"`suppress_notifications` when the message is sent, so changing it forces a new message.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("suppress_embeds", "suppress_notifications")),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"allowed_mentions": allowedMentionsBlock(),
+			"embed":            embedBlock(),
+			"poll":             pollBlock(),
+			"component":        componentBlock(),
+			"attachment":       attachmentBlock(),
+		},
 	}
 }
 
@@ -150,103 +194,355 @@ func (m boolRequiresReplace) PlanModifyBool(_ context.Context, req planmodifier.
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *messageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+// ValidateConfig checks the embeds and components against Discord's limits
+// at plan time.
+func (r *messageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateEmbedsConfig(ctx, req.Config, path.Root("embed"))...)
+	resp.Diagnostics.Append(validateAllowedMentionsConfig(ctx, req.Config, path.Root("allowed_mentions"))...)
+	resp.Diagnostics.Append(validatePollConfig(ctx, req.Config, path.Root("poll"))...)
+
+	var v2 types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("components_v2"), &v2)...)
+	if resp.Diagnostics.HasError() || v2.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validateComponentsConfig(ctx, req.Config, path.Root("component"), v2.ValueBool())...)
+
+	if !v2.ValueBool() {
+		return
+	}
+	var content types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	if !content.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Attribute Combination",
+			"Messages with components_v2 cannot have content. Use a text_display component instead.")
+	}
+	var embeds types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("embed"), &embeds)...)
+	if !embeds.IsNull() && !embeds.IsUnknown() && len(embeds.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("embed"), "Invalid Attribute Combination",
+			"Messages with components_v2 cannot have embed blocks. Use a container component instead.")
+	}
+	var poll types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("poll"), &poll)...)
+	if !poll.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("poll"), "Invalid Attribute Combination",
+			"Messages with components_v2 cannot have a poll.")
+	}
 }
 
-// buildEmbeds converts the embed models to Discord API embed objects.
-func buildEmbeds(embeds []embedModel) []*discord.Embed {
-	if len(embeds) == 0 {
-		return nil
+// ModifyPlan forces a new message when suppress_notifications or the poll
+// changes, and plans a publish for messages that are not yet crossposted and
+// the end of polls that are expired early. It hashes the attachment files so
+// that a changed file is uploaded again, and keeps the IDs and URLs of
+// attachments that are unchanged. Changes to a message written by another
+// account and publishes outside announcement channels fail here rather than
+// at apply time.
+func (r *messageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
 	}
-	result := make([]*discord.Embed, 0, len(embeds))
-	for _, e := range embeds {
-		embed := &discord.Embed{}
-		if !e.Title.IsNull() && !e.Title.IsUnknown() {
-			t := e.Title.ValueString()
-			embed.Title = &t
-		}
-		if !e.Description.IsNull() && !e.Description.IsUnknown() {
-			d := e.Description.ValueString()
-			embed.Description = &d
+
+	expiring := false
+	if !req.State.Raw.IsNull() {
+		var planFlags, stateFlags types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("flags"), &planFlags)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("flags"), &stateFlags)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if !e.URL.IsNull() && !e.URL.IsUnknown() {
-			u := e.URL.ValueString()
-			embed.URL = &u
+		if !planFlags.IsUnknown() &&
+			hasFlag(ctx, planFlags, "suppress_notifications") != hasFlag(ctx, stateFlags, "suppress_notifications") {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("flags"))
 		}
-		if !e.Color.IsNull() && !e.Color.IsUnknown() {
-			c := int(e.Color.ValueInt64())
-			embed.Color = &c
+
+		var publish, crossposted types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("publish"), &publish)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("crossposted"), &crossposted)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if !e.FooterText.IsNull() && !e.FooterText.IsUnknown() {
-			embed.Footer = &discord.EmbedFooter{
-				Text: e.FooterText.ValueString(),
-			}
+		if publish.ValueBool() && !crossposted.ValueBool() {
+			// Also retries a publish that failed after the message was sent.
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("crossposted"), types.BoolUnknown())...)
 		}
-		if !e.ImageURL.IsNull() && !e.ImageURL.IsUnknown() {
-			u := e.ImageURL.ValueString()
-			embed.Image = &discord.EmbedImage{
-				URL: &u,
-			}
+
+		var planPoll, statePoll *pollModel
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("poll"), &planPoll)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("poll"), &statePoll)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if !e.ThumbnailURL.IsNull() && !e.ThumbnailURL.IsUnknown() {
-			u := e.ThumbnailURL.ValueString()
-			embed.Thumbnail = &discord.EmbedImage{
-				URL: &u,
-			}
+		if pollReplaced(planPoll, statePoll) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("poll"))
+		} else if planPoll != nil && planPoll.Expire.ValueBool() && !pollEnded(statePoll) {
+			expiring = true
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("poll").AtName("expires_at"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("poll").AtName("finalized"), types.BoolUnknown())...)
 		}
-		result = append(result, embed)
 	}
-	return result
+
+	var publish, crossposted types.Bool
+	var channelID types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("publish"), &publish)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("crossposted"), &crossposted)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("channel_id"), &channelID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if publish.ValueBool() && crossposted.IsUnknown() {
+		resp.Diagnostics.Append(r.checkPublishChannel(ctx, channelID)...)
+	}
+
+	planMessageAttachments(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || (!expiring && !messageChanged(resp.Plan.Raw, req.State.Raw)) {
+		return
+	}
+
+	var authorID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("author_id"), &authorID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	foreign, err := r.isForeignMessage(ctx, authorID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Current User",
+			"Could not read the bot user to check the message author: "+err.Error(),
+		)
+		return
+	}
+	if foreign {
+		resp.Diagnostics.AddError(
+			"Message Is Read-Only",
+			fmt.Sprintf("The message was written by another account (user %s). Discord only lets the author of a "+
+				"message edit it or end its poll, so this resource manages it read-only and only pinned and "+
+				"publish can be changed. Revert the change in the configuration, or remove the resource to stop "+
+				"managing the message.",
+				authorID.ValueString()),
+		)
+	}
 }
 
-// flattenEmbeds converts Discord API embed objects to embed models.
-func flattenEmbeds(embeds []*discord.Embed) []embedModel {
-	if len(embeds) == 0 {
-		return nil
+// planMessageAttachments updates the planned attachments from the files on
+// disk.
+func planMessageAttachments(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var list types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attachment"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsUnknown() || len(list.Elements()) == 0 {
+		return
+	}
+	var planned []attachmentModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	result := make([]embedModel, 0, len(embeds))
-	for _, e := range embeds {
-		m := embedModel{}
-		if e.Title != nil {
-			m.Title = types.StringValue(*e.Title)
-		} else {
-			m.Title = types.StringNull()
+
+	var prior []attachmentModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("attachment"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if e.Description != nil {
-			m.Description = types.StringValue(*e.Description)
-		} else {
-			m.Description = types.StringNull()
+	}
+
+	planned, diags := planAttachments(planned, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attachment"), planned)...)
+}
+
+// unedited lists the attributes that are applied without editing the message.
+// Discord cannot edit polls, so poll changes either replace the message or
+// end the poll.
+var unedited = map[string]bool{
+	"pinned":      true,
+	"publish":     true,
+	"crossposted": true,
+	"poll":        true,
+}
+
+// messageChanged reports whether a plan changes any attribute that requires
+// editing the message.
+func messageChanged(plan, state tftypes.Value) bool {
+	var planned, current map[string]tftypes.Value
+	if err := plan.As(&planned); err != nil {
+		return true
+	}
+	if err := state.As(&current); err != nil {
+		return true
+	}
+	for name, value := range planned {
+		if !unedited[name] && !value.Equal(current[name]) {
+			return true
 		}
-		if e.URL != nil {
-			m.URL = types.StringValue(*e.URL)
-		} else {
-			m.URL = types.StringNull()
+	}
+	return false
+}
+
+// isForeignMessage reports whether a message was written by an account other
+// than the bot. Messages with an unknown author are assumed to be the bot's.
+func (r *messageResource) isForeignMessage(ctx context.Context, authorID types.String) (bool, error) {
+	if r.client == nil || authorID.IsNull() || authorID.IsUnknown() {
+		return false, nil
+	}
+	me, err := r.client.GetCurrentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	return me.ID.String() != authorID.ValueString(), nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *messageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// authorID returns the ID of the message author, or null if it is not known.
+func authorID(msg *discord.Message) types.String {
+	if msg.Author == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(msg.Author.ID.String())
+}
+
+// messageFlagBits returns the message flags for the flags and components_v2
+// attributes.
+func messageFlagBits(ctx context.Context, m messageModel) int {
+	bits := 0
+	for name, bit := range messageFlags {
+		if hasFlag(ctx, m.Flags, name) {
+			bits |= bit
 		}
-		if e.Color != nil {
-			m.Color = types.Int64Value(int64(*e.Color))
-		} else {
-			m.Color = types.Int64Null()
+	}
+	if m.ComponentsV2.ValueBool() {
+		bits |= discord.MessageFlagIsComponentsV2
+	}
+	return bits
+}
+
+// flattenMessageFlags converts message flags to the flags attribute. Flags
+// the attribute does not manage are ignored, and an unset attribute stays
+// null when none of its flags are set.
+func flattenMessageFlags(ctx context.Context, flags *int, prior types.Set) (types.Set, diag.Diagnostics) {
+	names := []string{}
+	if flags != nil {
+		for name, bit := range messageFlags {
+			if *flags&bit != 0 {
+				names = append(names, name)
+			}
 		}
-		if e.Footer != nil {
-			m.FooterText = types.StringValue(e.Footer.Text)
-		} else {
-			m.FooterText = types.StringNull()
+	}
+	if len(names) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, names)
+}
+
+// hasFlag reports whether a known flags set contains the named flag.
+func hasFlag(ctx context.Context, flags types.Set, name string) bool {
+	if flags.IsNull() || flags.IsUnknown() {
+		return false
+	}
+	var names []string
+	flags.ElementsAs(ctx, &names, false)
+	for _, n := range names {
+		if n == name {
+			return true
 		}
-		if e.Image != nil && e.Image.URL != nil {
-			m.ImageURL = types.StringValue(*e.Image.URL)
-		} else {
-			m.ImageURL = types.StringNull()
+	}
+	return false
+}
+
+// isMessagePinned reports whether a message appears in its channel's pinned messages.
+func (r *messageResource) isMessagePinned(ctx context.Context, channelID, messageID discord.Snowflake) (bool, error) {
+	pins, err := r.client.GetChannelPins(ctx, channelID)
+	if err != nil {
+		return false, err
+	}
+	for _, pin := range pins {
+		if pin.Message != nil && pin.Message.ID == messageID {
+			return true, nil
 		}
-		if e.Thumbnail != nil && e.Thumbnail.URL != nil {
-			m.ThumbnailURL = types.StringValue(*e.Thumbnail.URL)
-		} else {
-			m.ThumbnailURL = types.StringNull()
+	}
+	return false, nil
+}
+
+// setPinned pins or unpins a message, translating the per-channel pin limit
+// into a readable error.
+func (r *messageResource) setPinned(ctx context.Context, channelID, messageID discord.Snowflake, pinned bool) error {
+	if !pinned {
+		return r.client.UnpinMessage(ctx, channelID, messageID)
+	}
+	err := r.client.PinMessage(ctx, channelID, messageID)
+	if discord.HasErrorCode(err, discord.ErrCodeMaxPinsReached) {
+		return fmt.Errorf("channel %s has reached the maximum number of pinned messages; unpin a message before pinning another: %w", channelID, err)
+	}
+	return err
+}
+
+// publishMessage crossposts the message to the channels following its
+// announcement channel and records the result in m.
+func (r *messageResource) publishMessage(ctx context.Context, m *messageModel) error {
+	msg, err := r.client.CrosspostMessage(
+		ctx,
+		discord.Snowflake(m.ChannelID.ValueString()),
+		discord.Snowflake(m.ID.ValueString()),
+	)
+	if err != nil {
+		return err
+	}
+	m.Crossposted = isCrossposted(msg)
+	return nil
+}
+
+// checkPublishChannel fails when the channel is not an announcement channel.
+// Only messages in announcement channels can be published, so retrying the
+// publish on every apply would never succeed. The check is skipped while the
+// channel ID is not known.
+func (r *messageResource) checkPublishChannel(ctx context.Context, channelID types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || channelID.IsNull() || channelID.IsUnknown() {
+		return diags
+	}
+	ch, err := r.client.GetChannel(ctx, discord.Snowflake(channelID.ValueString()))
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return diags
 		}
-		result = append(result, m)
+		diags.AddError(
+			"Error Reading Channel",
+			"Could not read the channel to check that the message can be published: "+err.Error(),
+		)
+		return diags
+	}
+	if ch.Type != discord.ChannelTypeGuildAnnouncement {
+		diags.AddAttributeError(
+			path.Root("publish"),
+			"Invalid Publish Channel",
+			fmt.Sprintf("Channel %s is not an announcement channel. Only messages in announcement channels can be "+
+				"published; remove publish or move the message to an announcement channel.", channelID.ValueString()),
+		)
 	}
-	return result
+	return diags
+}
+
+// endPoll ends the poll of the message before its duration is up and
+// updates the poll from the ended message.
+func (r *messageResource) endPoll(ctx context.Context, m *messageModel) error {
+	msg, err := r.client.EndPoll(ctx, discord.Snowflake(m.ChannelID.ValueString()), discord.Snowflake(m.ID.ValueString()))
+	if err != nil {
+		return err
+	}
+	m.Poll = flattenPoll(msg, m.Poll)
+	return nil
+}
+
+// isCrossposted reports whether a message has been published.
+func isCrossposted(msg *discord.Message) types.Bool {
+	return types.BoolValue(msg.Flags != nil && *msg.Flags&discord.MessageFlagCrossposted != 0)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -257,6 +553,14 @@ func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if plan.Publish.ValueBool() {
+		// The channel may not have been known at plan time.
+		resp.Diagnostics.Append(r.checkPublishChannel(ctx, plan.ChannelID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	params := &discord.CreateMessageParams{}
 
 	if !plan.Content.IsNull() && !plan.Content.IsUnknown() {
@@ -270,6 +574,29 @@ func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	params.Embeds = buildEmbeds(plan.Embed)
+	params.Components = buildComponents(plan.Component)
+	if len(plan.Attachment) > 0 {
+		attachments, files, err := buildAttachments(plan.Attachment)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Message",
+				"Could not read attachment: "+err.Error(),
+			)
+			return
+		}
+		params.Attachments = attachments
+		params.Files = files
+	}
+	allowedMentions, diags := buildAllowedMentions(ctx, plan.AllowedMentions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.AllowedMentions = allowedMentions
+	params.Poll = buildPoll(plan.Poll)
+	if flags := messageFlagBits(ctx, plan); flags != 0 {
+		params.Flags = &flags
+	}
 
 	msg, err := r.client.CreateMessage(ctx, discord.Snowflake(plan.ChannelID.ValueString()), params)
 	if err != nil {
@@ -281,12 +608,58 @@ func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	plan.ID = types.StringValue(msg.ID.String())
+	plan.AuthorID = authorID(msg)
 	plan.TTS = types.BoolValue(msg.TTS)
 	if msg.Content != "" {
 		plan.Content = types.StringValue(msg.Content)
 	}
 	if len(msg.Embeds) > 0 {
-		plan.Embed = flattenEmbeds(msg.Embeds)
+		plan.Embed = flattenEmbeds(msg.Embeds, plan.Embed)
+	}
+	if len(msg.Components) > 0 {
+		plan.Component = flattenComponents(msg.Components, plan.Component)
+	}
+	plan.Attachment = resolveAttachments(plan.Attachment, msg.Attachments)
+	plan.Poll = flattenPoll(msg, plan.Poll)
+	plan.Crossposted = isCrossposted(msg)
+
+	if plan.Poll != nil && plan.Poll.Expire.ValueBool() {
+		if err := r.endPoll(ctx, &plan); err != nil {
+			// An error would taint the message and lose the poll, so only
+			// warn. The next plan retries ending the poll while it is open.
+			resp.Diagnostics.AddWarning(
+				"Poll Not Ended",
+				"The poll was sent but could not be ended, which is retried on the next apply: "+err.Error(),
+			)
+		}
+	}
+
+	if plan.Pinned.ValueBool() {
+		if err := r.setPinned(ctx, msg.ChannelID, msg.ID, true); err != nil {
+			// An error would taint the message and send it again, so only
+			// warn. The next plan retries the pin while pinned is false.
+			plan.Pinned = types.BoolValue(false)
+			resp.Diagnostics.AddWarning(
+				"Message Not Pinned",
+				"The message was sent but could not be pinned, which is retried on the next apply: "+err.Error(),
+			)
+		}
+	} else {
+		// A new message is not pinned, whether pinned is false or not set.
+		plan.Pinned = types.BoolValue(false)
+	}
+
+	if plan.Publish.ValueBool() {
+		if err := r.publishMessage(ctx, &plan); err != nil {
+			// An error would taint the message and send it again, so only
+			// warn. The next plan retries the publish while crossposted is
+			// false.
+			plan.Crossposted = types.BoolValue(false)
+			resp.Diagnostics.AddWarning(
+				"Message Not Published",
+				"The message was sent but could not be crossposted, which is retried on the next apply: "+err.Error(),
+			)
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -321,12 +694,38 @@ func (r *messageResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if msg.Content == "" {
 		state.Content = types.StringNull()
 	}
+	state.AuthorID = authorID(msg)
 	state.TTS = types.BoolValue(msg.TTS)
 	if len(msg.Embeds) > 0 {
-		state.Embed = flattenEmbeds(msg.Embeds)
+		state.Embed = flattenEmbeds(msg.Embeds, state.Embed)
 	} else {
 		state.Embed = nil
 	}
+	state.Component = flattenComponents(msg.Components, state.Component)
+	state.Attachment = refreshAttachments(state.Attachment, msg.Attachments)
+	state.Poll = flattenPoll(msg, state.Poll)
+	state.ComponentsV2 = types.BoolValue(msg.Flags != nil && *msg.Flags&discord.MessageFlagIsComponentsV2 != 0)
+	flags, diags := flattenMessageFlags(ctx, msg.Flags, state.Flags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Flags = flags
+	state.Crossposted = isCrossposted(msg)
+	if state.Publish.IsNull() {
+		// Imported messages are published when they were crossposted.
+		state.Publish = state.Crossposted
+	}
+
+	pinned, err := r.isMessagePinned(ctx, msg.ChannelID, msg.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Message",
+			"Could not read pinned messages: "+err.Error(),
+		)
+		return
+	}
+	state.Pinned = types.BoolValue(pinned)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -345,17 +744,118 @@ func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if messageChanged(req.Plan.Raw, req.State.Raw) {
+		resp.Diagnostics.Append(r.editMessage(ctx, &plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Only pinned, publish or the poll's expire changed, which do not
+		// edit the message.
+		pinned, publish, poll := plan.Pinned, plan.Publish, plan.Poll
+		plan = state
+		plan.Pinned = pinned
+		plan.Publish = publish
+		if poll != nil && state.Poll != nil {
+			p := *state.Poll
+			p.Expire = poll.Expire
+			plan.Poll = &p
+		}
+	}
+
+	if plan.Poll != nil && plan.Poll.Expire.ValueBool() && !pollEnded(plan.Poll) {
+		if err := r.endPoll(ctx, &plan); err != nil {
+			plan.Poll = state.Poll
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error Ending Poll",
+				"Could not end poll: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Pinned.Equal(state.Pinned) {
+		channelID := discord.Snowflake(plan.ChannelID.ValueString())
+		messageID := discord.Snowflake(plan.ID.ValueString())
+		if err := r.setPinned(ctx, channelID, messageID, plan.Pinned.ValueBool()); err != nil {
+			plan.Pinned = state.Pinned
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error Updating Message Pin",
+				"Could not update pin state: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.Publish.ValueBool() && !plan.Crossposted.ValueBool() {
+		if err := r.publishMessage(ctx, &plan); err != nil {
+			// The next plan retries the publish while crossposted is false.
+			plan.Crossposted = types.BoolValue(false)
+			resp.Diagnostics.AddWarning(
+				"Message Not Published",
+				"The message could not be crossposted, which is retried on the next apply: "+err.Error(),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// editMessage applies the planned content, embeds, components, attachments
+// and flags to the message and updates plan from the edited message.
+func (r *messageResource) editMessage(ctx context.Context, plan *messageModel, state messageModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	params := &discord.EditMessageParams{}
 
 	if !plan.Content.IsNull() && !plan.Content.IsUnknown() {
 		c := plan.Content.ValueString()
 		params.Content = &c
-	} else {
+	} else if !plan.ComponentsV2.ValueBool() {
 		empty := ""
 		params.Content = &empty
 	}
 
-	params.Embeds = buildEmbeds(plan.Embed)
+	embeds := buildEmbeds(plan.Embed)
+	if embeds == nil && !plan.ComponentsV2.ValueBool() {
+		// An empty list removes embeds that are no longer configured.
+		// Components V2 messages cannot have embeds, so none are sent.
+		embeds = []*discord.Embed{}
+	}
+	if embeds != nil {
+		params.Embeds = &embeds
+	}
+	components := buildComponents(plan.Component)
+	if components == nil {
+		// An empty list removes components that are no longer configured.
+		components = []*discord.Component{}
+	}
+	params.Components = &components
+	allowedMentions, d := buildAllowedMentions(ctx, plan.AllowedMentions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	params.AllowedMentions = allowedMentions
+	if !plan.Flags.Equal(state.Flags) {
+		flags := messageFlagBits(ctx, *plan)
+		params.Flags = &flags
+	}
+	if len(plan.Attachment) > 0 || len(state.Attachment) > 0 {
+		// Attachments left out of the list are removed from the message.
+		attachments, files, err := buildAttachments(plan.Attachment)
+		if err != nil {
+			diags.AddError(
+				"Error Updating Message",
+				"Could not read attachment: "+err.Error(),
+			)
+			return diags
+		}
+		params.Attachments = &attachments
+		params.Files = files
+	}
 
 	msg, err := r.client.EditMessage(
 		ctx,
@@ -364,11 +864,11 @@ func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest
 		params,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Error Updating Message",
 			"Could not update message: "+err.Error(),
 		)
-		return
+		return diags
 	}
 
 	plan.ID = state.ID
@@ -378,12 +878,16 @@ func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	plan.TTS = types.BoolValue(msg.TTS)
 	if len(msg.Embeds) > 0 {
-		plan.Embed = flattenEmbeds(msg.Embeds)
+		plan.Embed = flattenEmbeds(msg.Embeds, plan.Embed)
 	} else {
 		plan.Embed = nil
 	}
+	plan.Component = flattenComponents(msg.Components, plan.Component)
+	plan.Attachment = resolveAttachments(plan.Attachment, msg.Attachments)
+	plan.Poll = flattenPoll(msg, plan.Poll)
+	plan.Crossposted = isCrossposted(msg)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return diags
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -394,7 +898,24 @@ func (r *messageResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteMessage(
+	foreign, err := r.isForeignMessage(ctx, state.AuthorID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Message",
+			"Could not read the bot user to check the message author: "+err.Error(),
+		)
+		return
+	}
+	if foreign {
+		resp.Diagnostics.AddWarning(
+			"Message Not Deleted",
+			"The message was written by another account, so it was removed from the Terraform state "+
+				"but left in the channel.",
+		)
+		return
+	}
+
+	err = r.client.DeleteMessage(
 		ctx,
 		discord.Snowflake(state.ChannelID.ValueString()),
 		discord.Snowflake(state.ID.ValueString()),
@@ -0,0 +1,105 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ---------- TestMessageChanged ----------
+
+func TestMessageChanged(t *testing.T) {
+	t.Parallel()
+
+	schemaResp := &resource.SchemaResponse{}
+	NewMessageResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	pollType := objectType.AttributeTypes["poll"].(tftypes.Object)
+
+	// message returns a message value with the given attributes set and all
+	// others null.
+	message := func(attrs map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"id":         tftypes.NewValue(tftypes.String, "2"),
+			"channel_id": tftypes.NewValue(tftypes.String, "1"),
+			"content":    tftypes.NewValue(tftypes.String, "Hello"),
+		}
+		for name, value := range attrs {
+			values[name] = value
+		}
+		for name, typ := range objectType.AttributeTypes {
+			if _, ok := values[name]; !ok {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+	poll := func(expire bool) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"question": tftypes.NewValue(tftypes.String, "Q?"),
+			"expire":   tftypes.NewValue(tftypes.Bool, expire),
+		}
+		for name, typ := range pollType.AttributeTypes {
+			if _, ok := values[name]; !ok {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+		}
+		return tftypes.NewValue(pollType, values)
+	}
+	boolean := func(v bool) tftypes.Value { return tftypes.NewValue(tftypes.Bool, v) }
+
+	tests := []struct {
+		name     string
+		plan     map[string]tftypes.Value
+		state    map[string]tftypes.Value
+		expected bool
+	}{
+		{
+			name:     "unchanged",
+			expected: false,
+		},
+		{
+			name:     "pinned only",
+			plan:     map[string]tftypes.Value{"pinned": boolean(true)},
+			state:    map[string]tftypes.Value{"pinned": boolean(false)},
+			expected: false,
+		},
+		{
+			name:     "publish only",
+			plan:     map[string]tftypes.Value{"publish": boolean(true), "crossposted": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)},
+			state:    map[string]tftypes.Value{"publish": boolean(false), "crossposted": boolean(false)},
+			expected: false,
+		},
+		{
+			name:     "poll expired",
+			plan:     map[string]tftypes.Value{"poll": poll(true)},
+			state:    map[string]tftypes.Value{"poll": poll(false)},
+			expected: false,
+		},
+		{
+			name:     "content",
+			plan:     map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "Edited"), "pinned": boolean(true)},
+			state:    map[string]tftypes.Value{"pinned": boolean(true)},
+			expected: true,
+		},
+		{
+			name: "flags",
+			plan: map[string]tftypes.Value{"flags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "suppress_embeds"),
+			})},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := messageChanged(message(tc.plan), message(tc.state)); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &messageReactionResource{}
+	_ resource.ResourceWithConfigure   = &messageReactionResource{}
+	_ resource.ResourceWithImportState = &messageReactionResource{}
+)
+
+// reactionEmojiPattern matches a unicode emoji, which has no colons, or a
+// custom emoji as name:id.
+var reactionEmojiPattern = regexp.MustCompile(`^(?:[^:\s]+|\w+:\d+)$`)
+
+// NewMessageReactionResource is a constructor that returns a new message
+// reaction resource.
+func NewMessageReactionResource() resource.Resource {
+	return &messageReactionResource{}
+}
+
+// messageReactionResource is the resource implementation.
+type messageReactionResource struct {
+	client *discord.Client
+}
+
+// messageReactionModel maps the resource schema data.
+type messageReactionModel struct {
+	ID           types.String `tfsdk:"id"`
+	ChannelID    types.String `tfsdk:"channel_id"`
+	MessageID    types.String `tfsdk:"message_id"`
+	Emoji        types.String `tfsdk:"emoji"`
+	RemoveOthers types.Bool   `tfsdk:"remove_others"`
+}
+
+// Metadata returns the resource type name.
+func (r *messageReactionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message_reaction"
+}
+
+// Schema defines the schema for the resource.
+func (r *messageReactionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the bot's reaction with an emoji on a message, such as the reactions seeding a " +
+			"role menu. The message may be written by any account. When the bot's reaction is removed outside " +
+			"of Terraform it is added again on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the reaction, as channel_id/message_id/emoji.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel the message is in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The ID of the message to react to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emoji": schema.StringAttribute{
+				Description: "The emoji to react with: a unicode emoji such as `👍`, or `name:id` for a custom emoji.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(reactionEmojiPattern,
+						"must be a unicode emoji or name:id for a custom emoji"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remove_others": schema.BoolAttribute{
+				Description: "Whether to remove every other user's reaction with this emoji when the reaction is " +
+					"created or this is set to `true`. Reactions added later are kept. Requires the Manage Messages " +
+					"permission. A failure to remove them when the reaction is created is reported as a warning and " +
+					"retried on the next apply. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *messageReactionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// removeOtherReactions removes every reaction with the emoji except the bot's.
+func (r *messageReactionResource) removeOtherReactions(ctx context.Context, m messageReactionModel) error {
+	channelID := discord.Snowflake(m.ChannelID.ValueString())
+	messageID := discord.Snowflake(m.MessageID.ValueString())
+	emoji := m.Emoji.ValueString()
+
+	me, err := r.client.GetCurrentUser(ctx)
+	if err != nil {
+		return fmt.Errorf("could not read the bot user: %w", err)
+	}
+	users, err := r.client.GetReactions(ctx, channelID, messageID, emoji)
+	if err != nil {
+		return fmt.Errorf("could not list reactions: %w", err)
+	}
+	for _, u := range users {
+		if u.ID == me.ID {
+			continue
+		}
+		if err := r.client.DeleteUserReaction(ctx, channelID, messageID, emoji, u.ID); err != nil && !discord.IsNotFound(err) {
+			return fmt.Errorf("could not remove the reaction of user %s: %w", u.ID, err)
+		}
+	}
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *messageReactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan messageReactionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateReaction(
+		ctx,
+		discord.Snowflake(plan.ChannelID.ValueString()),
+		discord.Snowflake(plan.MessageID.ValueString()),
+		plan.Emoji.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Message Reaction",
+			"Could not add reaction: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s",
+		plan.ChannelID.ValueString(), plan.MessageID.ValueString(), plan.Emoji.ValueString()))
+
+	if plan.RemoveOthers.ValueBool() {
+		if err := r.removeOtherReactions(ctx, plan); err != nil {
+			// The reaction exists, so keep it and let the next apply retry
+			// the removal rather than tainting the resource.
+			plan.RemoveOthers = types.BoolValue(false)
+			resp.Diagnostics.AddWarning(
+				"Other Reactions Not Removed",
+				"The reaction was added but other users' reactions could not be removed, which is retried on "+
+					"the next apply: "+err.Error(),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data. The resource is
+// removed from state when the bot's reaction is gone, so that it is added
+// again.
+func (r *messageReactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state messageReactionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	msg, err := r.client.GetChannelMessage(
+		ctx,
+		discord.Snowflake(state.ChannelID.ValueString()),
+		discord.Snowflake(state.MessageID.ValueString()),
+	)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Message Reaction",
+			"Could not read message: "+err.Error(),
+		)
+		return
+	}
+
+	if !hasOwnReaction(msg, state.Emoji.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.RemoveOthers.IsNull() {
+		state.RemoveOthers = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// hasOwnReaction reports whether the bot has reacted to a message with an
+// emoji, given as a unicode emoji or as name:id.
+func hasOwnReaction(msg *discord.Message, emoji string) bool {
+	name, id, custom := strings.Cut(emoji, ":")
+	for _, reaction := range msg.Reactions {
+		if !reaction.Me {
+			continue
+		}
+		e := reaction.Emoji
+		if custom && e.ID != nil && e.ID.String() == id {
+			return true
+		}
+		if !custom && e.ID == nil && e.Name != nil && stripVariation(*e.Name) == stripVariation(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// stripVariation removes emoji variation selectors, which Discord does not
+// always keep, so that ❤️ and ❤ compare equal.
+func stripVariation(emoji string) string {
+	return strings.ReplaceAll(emoji, "\ufe0f", "")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only remove_others can change without replacing the reaction.
+func (r *messageReactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state messageReactionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RemoveOthers.ValueBool() && !state.RemoveOthers.ValueBool() {
+		if err := r.removeOtherReactions(ctx, plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing Other Reactions",
+				"Could not remove other users' reactions: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *messageReactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state messageReactionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOwnReaction(
+		ctx,
+		discord.Snowflake(state.ChannelID.ValueString()),
+		discord.Snowflake(state.MessageID.ValueString()),
+		state.Emoji.ValueString(),
+	)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Message Reaction",
+			"Could not remove reaction: "+err.Error(),
+		)
+	}
+}
+
+// ImportState implements the import by channel_id/message_id/emoji.
+func (r *messageReactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected import ID format: channel_id/message_id/emoji",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("message_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("emoji"), parts[2])...)
+}
@@ -0,0 +1,65 @@
+package message_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMessageReaction_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMessageReactionConfig(guildID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message_reaction.test", "emoji", "👍"),
+					resource.TestCheckResourceAttr("discord_message_reaction.test", "remove_others", "false"),
+					resource.TestCheckResourceAttrSet("discord_message_reaction.test", "id"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_message_reaction.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update remove_others in place
+			{
+				Config: testAccMessageReactionConfig(guildID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message_reaction.test", "remove_others", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageReactionConfig(guildID string, removeOthers bool) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "reaction_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-reaction-test"
+  type     = 0
+}
+
+resource "discord_message" "reaction_test" {
+  channel_id = discord_channel.reaction_test.id
+  content    = "React to vote"
+}
+
+resource "discord_message_reaction" "test" {
+  channel_id    = discord_channel.reaction_test.id
+  message_id    = discord_message.reaction_test.id
+  emoji         = "👍"
+  remove_others = %[2]t
+}
+`, guildID, removeOthers)
+}
@@ -3,10 +3,13 @@ package message_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -24,6 +27,7 @@ func TestAccMessage_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("discord_message.test", "content", "Hello from Terraform acceptance test"),
 					resource.TestCheckResourceAttrSet("discord_message.test", "id"),
 					resource.TestCheckResourceAttrSet("discord_message.test", "channel_id"),
+					resource.TestCheckResourceAttrSet("discord_message.test", "author_id"),
 				),
 			},
 			// ImportState
@@ -57,6 +61,174 @@ func TestAccMessage_embed(t *testing.T) {
 					resource.TestCheckResourceAttrSet("discord_message.embed", "id"),
 					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.title", "Test Embed"),
 					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.description", "Test description"),
+					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.author_name", "Terraform"),
+					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.timestamp", "2025-01-02T15:04:05Z"),
+					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.field.#", "2"),
+					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.field.0.inline", "true"),
+					resource.TestCheckResourceAttr("discord_message.embed", "embed.0.field.1.inline", "false"),
+				),
+			},
+			// Removing the last embed removes it from the message
+			{
+				Config: testAccMessageConfig_embedRemoved(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.embed", "embed.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMessage_components(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig_components(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.components", "component.#", "2"),
+					resource.TestCheckResourceAttr("discord_message.components", "component.0.button.#", "2"),
+					resource.TestCheckResourceAttr("discord_message.components", "component.0.button.0.style", "link"),
+					resource.TestCheckResourceAttr("discord_message.components", "component.1.select.0.option.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "discord_message.components",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateMessage("discord_message.components"),
+			},
+		},
+	})
+}
+
+func TestAccMessage_componentsV2(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig_componentsV2(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.layout", "components_v2", "true"),
+					resource.TestCheckResourceAttr("discord_message.layout", "component.0.type", "container"),
+					resource.TestCheckResourceAttr("discord_message.layout", "component.0.component.#", "3"),
+					resource.TestCheckResourceAttr("discord_message.layout", "component.0.component.1.texts.0", "Get notified about events"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMessage_attachment(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	filePath := filepath.Join(t.TempDir(), "notes.txt")
+
+	writeFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeFile("first version")()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig_attachment(guildID, filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.attachment", "attachment.#", "1"),
+					resource.TestCheckResourceAttr("discord_message.attachment", "attachment.0.content_hash", "80d8f975e768eecac59d22a788bf8e811e51ca85e309ee47f1e821e3e58280f2"),
+					resource.TestCheckResourceAttrSet("discord_message.attachment", "attachment.0.id"),
+					resource.TestCheckResourceAttrSet("discord_message.attachment", "attachment.0.url"),
+				),
+			},
+			// Changing the file content uploads it again.
+			{
+				PreConfig: writeFile("second version"),
+				Config:    testAccMessageConfig_attachment(guildID, filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.attachment", "attachment.#", "1"),
+					resource.TestCheckResourceAttrSet("discord_message.attachment", "attachment.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMessage_flags(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig_flags(guildID, `["suppress_embeds", "suppress_notifications"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.flags", "flags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("discord_message.flags", "flags.*", "suppress_embeds"),
+					resource.TestCheckResourceAttr("discord_message.flags", "allowed_mentions.parse.#", "0"),
+				),
+			},
+			// suppress_embeds can be removed in place.
+			{
+				Config: testAccMessageConfig_flags(guildID, `["suppress_notifications"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.flags", "flags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("discord_message.flags", "flags.*", "suppress_notifications"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMessage_pinned(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create pinned
+			{
+				Config: testAccMessageConfig_pinned(guildID, "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.pin", "pinned", "true"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_message.pin",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateMessage("discord_message.pin"),
+			},
+			// Leaving pinned unset keeps the existing pin
+			{
+				Config: testAccMessageConfig_pinned(guildID, "null"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.pin", "pinned", "true"),
+				),
+			},
+			// Unpin
+			{
+				Config: testAccMessageConfig_pinned(guildID, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.pin", "pinned", "false"),
 				),
 			},
 		},
@@ -119,7 +291,477 @@ resource "discord_message" "embed" {
     title       = "Test Embed"
     description = "Test description"
     color       = 16711680
+    timestamp   = "2025-01-02T15:04:05Z"
+    author_name = "Terraform"
+    footer_text = "Test footer"
+
+    field {
+      name   = "Status"
+      value  = "Online"
+      inline = true
+    }
+
+    field {
+      name  = "Region"
+      value = "Europe"
+    }
+  }
+}
+`, guildID)
+}
+
+func testAccMessageConfig_embedRemoved(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "embed_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-embed-test"
+  type     = 0
+}
+
+resource "discord_message" "embed" {
+  channel_id = discord_channel.embed_test.id
+  content    = "Message with embed"
+}
+`, guildID)
+}
+
+func testAccMessageConfig_components(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "components_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-components-test"
+  type     = 0
+}
+
+resource "discord_message" "components" {
+  channel_id = discord_channel.components_test.id
+  content    = "Message with components"
+
+  component {
+    type = "action_row"
+
+    button {
+      style = "link"
+      label = "Documentation"
+      url   = "https://discord.com/developers/docs"
+    }
+
+    button {
+      style     = "primary"
+      label     = "Accept"
+      emoji     = "✅"
+      custom_id = "tf-acc-accept"
+    }
+  }
+
+  component {
+    type = "action_row"
+
+    select {
+      type        = "string"
+      custom_id   = "tf-acc-colour"
+      placeholder = "Pick a colour"
+
+      option {
+        label = "Red"
+        value = "red"
+      }
+
+      option {
+        label = "Blue"
+        value = "blue"
+      }
+    }
+  }
+}
+`, guildID)
+}
+
+func testAccMessageConfig_componentsV2(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "layout_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-layout-test"
+  type     = 0
+}
+
+resource "discord_message" "layout" {
+  channel_id    = discord_channel.layout_test.id
+  components_v2 = true
+
+  component {
+    type         = "container"
+    accent_color = 5793266
+
+    component {
+      type    = "text_display"
+      content = "# Roles"
+    }
+
+    component {
+      type  = "section"
+      texts = ["Get notified about events"]
+
+      button {
+        style     = "success"
+        label     = "Subscribe"
+        custom_id = "tf-acc-subscribe"
+      }
+    }
+
+    component {
+      type = "action_row"
+
+      select {
+        type       = "role"
+        custom_id  = "tf-acc-roles"
+        min_values = 0
+        max_values = 2
+      }
+    }
+  }
+}
+`, guildID)
+}
+
+func testAccMessageConfig_attachment(guildID, filePath string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "attachment_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-attachment-test"
+  type     = 0
+}
+
+resource "discord_message" "attachment" {
+  channel_id = discord_channel.attachment_test.id
+  content    = "Message with an attachment"
+
+  attachment {
+    path        = %[2]q
+    description = "Release notes"
+  }
+}
+`, guildID, filePath)
+}
+
+func testAccMessageConfig_flags(guildID, flags string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "flags_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-flags-test"
+  type     = 0
+}
+
+resource "discord_message" "flags" {
+  channel_id = discord_channel.flags_test.id
+  content    = "Silent message for @everyone with a link: https://discord.com"
+  flags      = %[2]s
+
+  allowed_mentions {
+    parse = []
   }
 }
+`, guildID, flags)
+}
+
+func testAccMessageConfig_pinned(guildID, pinned string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "pin_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-pin-test"
+  type     = 0
+}
+
+resource "discord_message" "pin" {
+  channel_id = discord_channel.pin_test.id
+  content    = "Pinned rules message"
+  pinned     = %[2]s
+}
+`, guildID, pinned)
+}
+
+func TestAccMessage_foreignAuthor(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Post a message through a webhook
+			{
+				Config: testAccMessageConfig_foreign(guildID, "posted", "", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discord_webhook_message.test", "id"),
+				),
+			},
+			// Import it, which needs the message ID to be known at plan time
+			{
+				Config: testAccMessageConfig_foreign(guildID, "managed", "Posted by a webhook", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_message.foreign", "id", "discord_webhook_message.test", "id"),
+					resource.TestCheckResourceAttrPair("discord_message.foreign", "author_id", "discord_webhook.test", "id"),
+					resource.TestCheckResourceAttr("discord_message.foreign", "content", "Posted by a webhook"),
+				),
+			},
+			// Pinning does not edit the message, so it is allowed
+			{
+				Config: testAccMessageConfig_foreign(guildID, "managed", "Posted by a webhook", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.foreign", "pinned", "true"),
+				),
+			},
+			// Editing the content fails at plan time
+			{
+				Config:      testAccMessageConfig_foreign(guildID, "managed", "Edited by Terraform", true),
+				ExpectError: regexp.MustCompile(`Message Is Read-Only`),
+			},
+			// Destroying the resource leaves the message in the channel
+			{
+				Config: testAccMessageConfig_foreign(guildID, "posted", "", false),
+			},
+			{
+				Config: testAccMessageConfig_foreign(guildID, "read", "", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.discord_message.check", "id", "discord_webhook_message.test", "id"),
+					resource.TestCheckResourceAttr("data.discord_message.check", "content", "Posted by a webhook"),
+				),
+			},
+		},
+	})
+}
+
+// testAccMessageConfig_foreign posts a message through a webhook. In the
+// managed stage the message is imported into discord_message, and in the read
+// stage it is read back with the discord_message data source.
+func testAccMessageConfig_foreign(guildID, stage, content string, pinned bool) string {
+	config := fmt.Sprintf(`
+resource "discord_channel" "foreign_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-foreign-msg-test"
+  type     = 0
+}
+
+resource "discord_webhook" "test" {
+  channel_id = discord_channel.foreign_test.id
+  name       = "tf-acc-foreign-msg"
+}
+
+resource "discord_webhook_message" "test" {
+  webhook_url = discord_webhook.test.url
+  content     = "Posted by a webhook"
+}
 `, guildID)
+
+	switch stage {
+	case "managed":
+		config += fmt.Sprintf(`
+import {
+  to = discord_message.foreign
+  id = "${discord_channel.foreign_test.id}/${discord_webhook_message.test.id}"
+}
+
+resource "discord_message" "foreign" {
+  channel_id = discord_channel.foreign_test.id
+  content    = %[1]q
+  pinned     = %[2]t
+}
+`, content, pinned)
+	case "read":
+		config += `
+data "discord_message" "check" {
+  channel_id = discord_channel.foreign_test.id
+  id         = discord_webhook_message.test.id
+}
+`
+	}
+	return config
+}
+
+func TestAccMessage_publish(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create unpublished
+			{
+				Config: testAccMessageConfig_publish(guildID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.announcement", "publish", "false"),
+					resource.TestCheckResourceAttr("discord_message.announcement", "crossposted", "false"),
+				),
+			},
+			// Publish
+			{
+				Config: testAccMessageConfig_publish(guildID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.announcement", "publish", "true"),
+					resource.TestCheckResourceAttr("discord_message.announcement", "crossposted", "true"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_message.announcement",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateMessage("discord_message.announcement"),
+			},
+		},
+	})
+}
+
+func TestAccMessage_publishTextChannel(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig_publishTextChannel(guildID, false),
+			},
+			// Publishing outside an announcement channel fails at plan time
+			{
+				Config:      testAccMessageConfig_publishTextChannel(guildID, true),
+				ExpectError: regexp.MustCompile(`Invalid Publish Channel`),
+			},
+		},
+	})
+}
+
+func testAccMessageConfig_publish(guildID string, publish bool) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "announcement_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-announcement-test"
+  type     = 5
+}
+
+resource "discord_message" "announcement" {
+  channel_id = discord_channel.announcement_test.id
+  content    = "Release notes"
+  publish    = %[2]t
+}
+`, guildID, publish)
+}
+
+func testAccMessageConfig_publishTextChannel(guildID string, publish bool) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "publish_text_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-publish-text-test"
+  type     = 0
+}
+
+resource "discord_message" "text" {
+  channel_id = discord_channel.publish_text_test.id
+  content    = "Not an announcement"
+  publish    = %[2]t
+}
+`, guildID, publish)
+}
+
+func TestAccMessage_poll(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccMessageConfig_poll(guildID, "48", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.test", "poll.question", "Which day works best?"),
+					resource.TestCheckResourceAttr("discord_message.test", "poll.duration", "48"),
+					resource.TestCheckResourceAttr("discord_message.test", "poll.answer.#", "2"),
+					resource.TestCheckResourceAttrSet("discord_message.test", "poll.answer.0.answer_id"),
+					resource.TestCheckResourceAttr("discord_message.test", "poll.answer.0.vote_count", "0"),
+					resource.TestCheckResourceAttrSet("discord_message.test", "poll.expires_at"),
+					resource.TestCheckResourceAttr("discord_message.test", "poll.finalized", "false"),
+				),
+			},
+			// ImportState, before the poll ends and its expiry moves
+			{
+				ResourceName:            "discord_message.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateMessage("discord_message.test"),
+				ImportStateVerifyIgnore: []string{"poll.expire"},
+			},
+			// Expire early
+			{
+				Config: testAccMessageConfig_poll(guildID, "48", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.test", "poll.expire", "true"),
+					resource.TestCheckResourceAttr("discord_message.test", "poll.finalized", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccMessage_pollExpireDefaultDuration ends a poll that uses the default
+// duration and expects the message not to be replaced afterwards.
+func TestAccMessage_pollExpireDefaultDuration(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig_poll(guildID, "null", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("discord_message.test", "poll.duration"),
+				),
+			},
+			{
+				Config: testAccMessageConfig_poll(guildID, "null", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discord_message.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_message.test", "poll.finalized", "true"),
+					resource.TestCheckNoResourceAttr("discord_message.test", "poll.duration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageConfig_poll(guildID, duration string, expire bool) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "msg_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-msg-test"
+  type     = 0
+}
+
+resource "discord_message" "test" {
+  channel_id = discord_channel.msg_test.id
+
+  poll {
+    question          = "Which day works best?"
+    duration          = %[2]s
+    allow_multiselect = true
+    expire            = %[3]t
+
+    answer {
+      text  = "Saturday"
+      emoji = "🎉"
+    }
+
+    answer {
+      text = "Sunday"
+    }
+  }
+}
+`, guildID, duration, expire)
 }
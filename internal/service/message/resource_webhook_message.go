@@ -0,0 +1,352 @@
+package message
+
+import (
+	"context"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &webhookMessageResource{}
+	_ resource.ResourceWithConfigure      = &webhookMessageResource{}
+	_ resource.ResourceWithValidateConfig = &webhookMessageResource{}
+)
+
+// NewWebhookMessageResource is a constructor that returns a new webhook
+// message resource.
+func NewWebhookMessageResource() resource.Resource {
+	return &webhookMessageResource{}
+}
+
+// webhookMessageResource is the resource implementation.
+type webhookMessageResource struct {
+	client *discord.Client
+}
+
+// webhookMessageModel maps the resource schema data.
+type webhookMessageModel struct {
+	ID         types.String `tfsdk:"id"`
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	WebhookID  types.String `tfsdk:"webhook_id"`
+	ChannelID  types.String `tfsdk:"channel_id"`
+	ThreadID   types.String `tfsdk:"thread_id"`
+	Content    types.String `tfsdk:"content"`
+	Username   types.String `tfsdk:"username"`
+	AvatarURL  types.String `tfsdk:"avatar_url"`
+	Embed      []embedModel `tfsdk:"embed"`
+}
+
+// webhookURLRequiresReplace forces replacement when the webhook URL points at
+// a different webhook. A message can only be edited through the webhook that
+// sent it, but a regenerated token for the same webhook still works.
+func webhookURLRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+	stateID, _, stateErr := discord.ParseWebhookURL(req.StateValue.ValueString())
+	planID, _, planErr := discord.ParseWebhookURL(req.PlanValue.ValueString())
+	resp.RequiresReplace = stateErr != nil || planErr != nil || stateID != planID
+}
+
+// Metadata returns the resource type name.
+func (r *webhookMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_message"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookMessageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a message sent through a Discord webhook. The webhook URL authenticates every " +
+			"request, so this resource works without a bot token and needs no bot permissions in the channel. " +
+			"Edits made to the message outside of Terraform show up as drift and are reverted on apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the message.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "The URL of the webhook to send the message with, such as " +
+					"`https://discord.com/api/webhooks/{id}/{token}`. Changing the webhook sends a new message, " +
+					"while a new token for the same webhook keeps the message.",
+				Required:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						webhookURLRequiresReplace,
+						"Changing the webhook sends a new message.",
+						"Changing the webhook sends a new message.",
+					),
+				},
+			},
+			"webhook_id": schema.StringAttribute{
+				Description: "The ID of the webhook, taken from `webhook_url`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel the message was sent in.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"thread_id": schema.StringAttribute{
+				Description: "The ID of a thread in the webhook's channel to send the message in. Changing this " +
+					"forces a new message.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the message. At least one of `content` or `embed` must be set.",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Overrides the webhook's default username (1-80 characters). Discord only applies it " +
+					"when the message is sent, so changing it forces a new message.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"avatar_url": schema.StringAttribute{
+				Description: "Overrides the webhook's default avatar with the image at this URL. Discord only " +
+					"applies it when the message is sent, so changing it forces a new message.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"embed": embedBlock(),
+		},
+	}
+}
+
+// ValidateConfig checks the webhook URL, the embed limits, and that the
+// message is not empty.
+func (r *webhookMessageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateEmbedsConfig(ctx, req.Config, path.Root("embed"))...)
+
+	var webhookURL, content types.String
+	var embeds types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook_url"), &webhookURL)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("embed"), &embeds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !webhookURL.IsNull() && !webhookURL.IsUnknown() {
+		if _, _, err := discord.ParseWebhookURL(webhookURL.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Webhook URL", err.Error())
+		}
+	}
+
+	if content.IsNull() && !embeds.IsUnknown() && len(embeds.Elements()) == 0 {
+		resp.Diagnostics.AddError("Missing Message Content",
+			"At least one of content or embed must be set.")
+	}
+}
+
+// Configure adds the provider configured client to the resource. A bot token
+// is not required, since the webhook token authenticates every request.
+func (r *webhookMessageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.WebhookClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// webhook returns the webhook ID and token from the webhook_url attribute.
+func (m webhookMessageModel) webhook() (discord.Snowflake, string, error) {
+	return discord.ParseWebhookURL(m.WebhookURL.ValueString())
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webhookMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookMessageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, token, err := plan.webhook()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Webhook URL", err.Error())
+		return
+	}
+
+	params := &discord.ExecuteWebhookParams{
+		Content:   stringPointer(plan.Content),
+		Username:  stringPointer(plan.Username),
+		AvatarURL: stringPointer(plan.AvatarURL),
+		Embeds:    buildEmbeds(plan.Embed),
+	}
+
+	msg, err := r.client.ExecuteWebhook(ctx, webhookID, token, discord.Snowflake(plan.ThreadID.ValueString()), params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Webhook Message",
+			"Could not execute webhook: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(msg.ID.String())
+	plan.WebhookID = types.StringValue(webhookID.String())
+	r.flattenMessage(msg, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webhookMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookMessageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, token, err := state.webhook()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Webhook URL", err.Error())
+		return
+	}
+
+	msg, err := r.client.GetWebhookMessage(
+		ctx,
+		webhookID,
+		token,
+		discord.Snowflake(state.ID.ValueString()),
+		discord.Snowflake(state.ThreadID.ValueString()),
+	)
+	if err != nil {
+		// The message is gone when either it or the webhook was deleted.
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Webhook Message",
+			"Could not read webhook message: "+err.Error(),
+		)
+		return
+	}
+
+	state.WebhookID = types.StringValue(webhookID.String())
+	r.flattenMessage(msg, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webhookMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookMessageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state webhookMessageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, token, err := plan.webhook()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Webhook URL", err.Error())
+		return
+	}
+
+	content := plan.Content.ValueString()
+	embeds := buildEmbeds(plan.Embed)
+	if embeds == nil {
+		// An empty list removes embeds that are no longer configured.
+		embeds = []*discord.Embed{}
+	}
+	params := &discord.EditWebhookMessageParams{
+		Content: &content,
+		Embeds:  &embeds,
+	}
+
+	msg, err := r.client.EditWebhookMessage(
+		ctx,
+		webhookID,
+		token,
+		discord.Snowflake(state.ID.ValueString()),
+		discord.Snowflake(plan.ThreadID.ValueString()),
+		params,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Webhook Message",
+			"Could not update webhook message: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.WebhookID = types.StringValue(webhookID.String())
+	r.flattenMessage(msg, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webhookMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookMessageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, token, err := state.webhook()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Webhook URL", err.Error())
+		return
+	}
+
+	err = r.client.DeleteWebhookMessage(
+		ctx,
+		webhookID,
+		token,
+		discord.Snowflake(state.ID.ValueString()),
+		discord.Snowflake(state.ThreadID.ValueString()),
+	)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Webhook Message",
+			"Could not delete webhook message: "+err.Error(),
+		)
+	}
+}
+
+// flattenMessage maps the message Discord returned onto the model.
+func (r *webhookMessageResource) flattenMessage(msg *discord.Message, m *webhookMessageModel) {
+	m.ChannelID = types.StringValue(msg.ChannelID.String())
+	m.Content = types.StringValue(msg.Content)
+	if msg.Content == "" {
+		m.Content = types.StringNull()
+	}
+	m.Embed = flattenEmbeds(msg.Embeds, m.Embed)
+}
@@ -0,0 +1,46 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ---------- TestWebhookURLRequiresReplace ----------
+
+func TestWebhookURLRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	const url = "https://discord.com/api/webhooks/123/old-token"
+
+	tests := []struct {
+		name     string
+		state    types.String
+		plan     types.String
+		expected bool
+	}{
+		{name: "create", state: types.StringNull(), plan: types.StringValue(url), expected: true},
+		{name: "new token", state: types.StringValue(url), plan: types.StringValue("https://discord.com/api/webhooks/123/new-token"), expected: false},
+		{name: "new webhook", state: types.StringValue(url), plan: types.StringValue("https://discord.com/api/webhooks/456/old-token"), expected: true},
+		{name: "unknown", state: types.StringValue(url), plan: types.StringUnknown(), expected: true},
+		{name: "invalid", state: types.StringValue(url), plan: types.StringValue("not a webhook"), expected: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+			webhookURLRequiresReplace(context.Background(), planmodifier.StringRequest{
+				StateValue: tc.state,
+				PlanValue:  tc.plan,
+			}, resp)
+			if resp.RequiresReplace != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, resp.RequiresReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package message_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookMessage_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccWebhookMessageConfig(guildID, "Hello from a webhook"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_webhook_message.test", "content", "Hello from a webhook"),
+					resource.TestCheckResourceAttr("discord_webhook_message.test", "username", "tf-acc-announcer"),
+					resource.TestCheckResourceAttr("discord_webhook_message.test", "embed.0.title", "Status"),
+					resource.TestCheckResourceAttrSet("discord_webhook_message.test", "id"),
+					resource.TestCheckResourceAttrPair("discord_webhook_message.test", "webhook_id", "discord_webhook.test", "id"),
+					resource.TestCheckResourceAttrPair("discord_webhook_message.test", "channel_id", "discord_channel.webhook_msg_test", "id"),
+				),
+			},
+			// Update
+			{
+				Config: testAccWebhookMessageConfig(guildID, "Edited webhook message"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_webhook_message.test", "content", "Edited webhook message"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookMessageConfig(guildID, content string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "webhook_msg_test" {
+  guild_id = %[1]q
+  name     = "tf-acc-webhook-msg-test"
+  type     = 0
+}
+
+resource "discord_webhook" "test" {
+  channel_id = discord_channel.webhook_msg_test.id
+  name       = "tf-acc-webhook-msg"
+}
+
+resource "discord_webhook_message" "test" {
+  webhook_url = discord_webhook.test.url
+  content     = %[2]q
+  username    = "tf-acc-announcer"
+
+  embed {
+    title       = "Status"
+    description = "All systems operational."
+  }
+}
+`, guildID, content)
+}
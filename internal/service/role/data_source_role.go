@@ -28,6 +28,7 @@ type roleDataSourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Permissions types.String `tfsdk:"permissions"`
 	Color       types.Int64  `tfsdk:"color"`
+	Colors      types.Object `tfsdk:"colors"`
 	Hoist       types.Bool   `tfsdk:"hoist"`
 	Mentionable types.Bool   `tfsdk:"mentionable"`
 	Position    types.Int64  `tfsdk:"position"`
@@ -76,6 +77,24 @@ func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "The integer representation of the role color.",
 				Computed:    true,
 			},
+			"colors": schema.SingleNestedAttribute{
+				Description: "The role colors, including the secondary and tertiary colors of gradient and holographic roles.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"primary_color": schema.Int64Attribute{
+						Description: "The primary RGB color value of the role.",
+						Computed:    true,
+					},
+					"secondary_color": schema.Int64Attribute{
+						Description: "The secondary RGB color value of a gradient or holographic role.",
+						Computed:    true,
+					},
+					"tertiary_color": schema.Int64Attribute{
+						Description: "The tertiary RGB color value of a holographic role.",
+						Computed:    true,
+					},
+				},
+			},
 			"hoist": schema.BoolAttribute{
 				Description: "Whether this role is hoisted (displayed separately in the sidebar).",
 				Computed:    true,
@@ -155,6 +174,9 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	config.Name = types.StringValue(found.Name)
 	config.Permissions = types.StringValue(found.Permissions)
 	config.Color = types.Int64Value(int64(found.Color))
+	colors, diags := flattenRoleColors(found)
+	resp.Diagnostics.Append(diags...)
+	config.Colors = colors
 	config.Hoist = types.BoolValue(found.Hoist)
 	config.Mentionable = types.BoolValue(found.Mentionable)
 	config.Position = types.Int64Value(int64(found.Position))
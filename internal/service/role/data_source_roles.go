@@ -0,0 +1,316 @@
+package role
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	client *discord.Client
+}
+
+// rolesDataSourceModel maps the data source schema data.
+type rolesDataSourceModel struct {
+	GuildID    types.String `tfsdk:"guild_id"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Managed    types.Bool   `tfsdk:"managed"`
+	Permission types.String `tfsdk:"permission"`
+	Roles      types.List   `tfsdk:"roles"`
+}
+
+// NewRolesDataSource returns a new roles data source.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// roleTagsAttrTypes returns the attr.Type map for a role tags object.
+func roleTagsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"bot_id":                  types.StringType,
+		"integration_id":          types.StringType,
+		"premium_subscriber":      types.BoolType,
+		"subscription_listing_id": types.StringType,
+		"available_for_purchase":  types.BoolType,
+		"guild_connections":       types.BoolType,
+	}
+}
+
+// roleObjectAttrTypes returns the attr.Type map for a role object.
+func roleObjectAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":               types.StringType,
+		"name":             types.StringType,
+		"permissions":      types.StringType,
+		"permission_names": types.SetType{ElemType: types.StringType},
+		"color":            types.Int64Type,
+		"hoist":            types.BoolType,
+		"mentionable":      types.BoolType,
+		"position":         types.Int64Type,
+		"managed":          types.BoolType,
+		"tags":             types.ObjectType{AttrTypes: roleTagsAttrTypes()},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the roles of a Discord guild, optionally filtered by name, " +
+			"managed flag or permission.",
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild to list roles for.",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return roles whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"managed": schema.BoolAttribute{
+				Description: "Only return roles that are (true) or are not (false) managed by an integration.",
+				Optional:    true,
+			},
+			"permission": schema.StringAttribute{
+				Description: "Only return roles that grant this permission, as a name from the discord_permission " +
+					"data source (e.g. manage_messages).",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(discord.PermissionNames()...),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The matching roles, from the top of the hierarchy to the bottom.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the role.",
+							Computed:    true,
+						},
+						"permissions": schema.StringAttribute{
+							Description: "The permission bit set for this role.",
+							Computed:    true,
+						},
+						"permission_names": schema.SetAttribute{
+							Description: "The permissions for this role as names.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"color": schema.Int64Attribute{
+							Description: "The integer representation of the role color.",
+							Computed:    true,
+						},
+						"hoist": schema.BoolAttribute{
+							Description: "Whether this role is hoisted (displayed separately in the sidebar).",
+							Computed:    true,
+						},
+						"mentionable": schema.BoolAttribute{
+							Description: "Whether this role is mentionable.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of this role.",
+							Computed:    true,
+						},
+						"managed": schema.BoolAttribute{
+							Description: "Whether this role is managed by an integration.",
+							Computed:    true,
+						},
+						"tags": schema.SingleNestedAttribute{
+							Description: "The tags of this role. Null when the role has no tags.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"bot_id": schema.StringAttribute{
+									Description: "The ID of the bot this role belongs to.",
+									Computed:    true,
+								},
+								"integration_id": schema.StringAttribute{
+									Description: "The ID of the integration this role belongs to.",
+									Computed:    true,
+								},
+								"premium_subscriber": schema.BoolAttribute{
+									Description: "Whether this is the guild's Booster role.",
+									Computed:    true,
+								},
+								"subscription_listing_id": schema.StringAttribute{
+									Description: "The ID of this role's subscription SKU and listing.",
+									Computed:    true,
+								},
+								"available_for_purchase": schema.BoolAttribute{
+									Description: "Whether this role is available for purchase.",
+									Computed:    true,
+								},
+								"guild_connections": schema.BoolAttribute{
+									Description: "Whether this role is a guild's linked role.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config rolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				"Could not compile name_regex: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var permissionBit uint64
+	if !config.Permission.IsNull() {
+		bits, err := discord.PermissionBitsFromNames([]string{config.Permission.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("permission"), "Invalid Permission Name", err.Error())
+			return
+		}
+		permissionBit, _ = strconv.ParseUint(bits, 10, 64)
+	}
+
+	guildID := discord.Snowflake(config.GuildID.ValueString())
+	roles, err := d.client.GetGuildRoles(ctx, guildID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Guild Roles",
+			"Could not read guild roles for guild "+config.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// List in hierarchy order with @everyone at the bottom.
+	ordered := sortRolesTopDown(guildID, roles)
+	for _, role := range roles {
+		if role.ID == guildID {
+			ordered = append(ordered, role)
+		}
+	}
+
+	roleObjects := make([]attr.Value, 0, len(ordered))
+	for _, role := range ordered {
+		if nameRegex != nil && !nameRegex.MatchString(role.Name) {
+			continue
+		}
+		if !config.Managed.IsNull() && role.Managed != config.Managed.ValueBool() {
+			continue
+		}
+		if permissionBit != 0 {
+			bits, err := strconv.ParseUint(role.Permissions, 10, 64)
+			if err != nil || bits&permissionBit == 0 {
+				continue
+			}
+		}
+
+		obj, diags := flattenRoleObject(ctx, role)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		roleObjects = append(roleObjects, obj)
+	}
+
+	rolesList, diags := types.ListValue(types.ObjectType{AttrTypes: roleObjectAttrTypes()}, roleObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Roles = rolesList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// flattenRoleObject converts a Discord role to a role object value.
+func flattenRoleObject(ctx context.Context, role *discord.Role) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissionNames, d := common.PermissionNamesSetFromBits(ctx, role.Permissions)
+	diags.Append(d...)
+
+	tags := types.ObjectNull(roleTagsAttrTypes())
+	if role.Tags != nil {
+		tags, d = types.ObjectValue(roleTagsAttrTypes(), map[string]attr.Value{
+			"bot_id":                  snowflakeValue(role.Tags.BotID),
+			"integration_id":          snowflakeValue(role.Tags.IntegrationID),
+			"premium_subscriber":      types.BoolValue(role.Tags.PremiumSubscriber),
+			"subscription_listing_id": snowflakeValue(role.Tags.SubscriptionListingID),
+			"available_for_purchase":  types.BoolValue(role.Tags.AvailableForPurchase),
+			"guild_connections":       types.BoolValue(role.Tags.GuildConnections),
+		})
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return types.ObjectNull(roleObjectAttrTypes()), diags
+	}
+
+	obj, d := types.ObjectValue(roleObjectAttrTypes(), map[string]attr.Value{
+		"id":               types.StringValue(role.ID.String()),
+		"name":             types.StringValue(role.Name),
+		"permissions":      types.StringValue(role.Permissions),
+		"permission_names": permissionNames,
+		"color":            types.Int64Value(int64(role.Color)),
+		"hoist":            types.BoolValue(role.Hoist),
+		"mentionable":      types.BoolValue(role.Mentionable),
+		"position":         types.Int64Value(int64(role.Position)),
+		"managed":          types.BoolValue(role.Managed),
+		"tags":             tags,
+	})
+	diags.Append(d...)
+	return obj, diags
+}
+
+// snowflakeValue converts an optional snowflake to a string value.
+func snowflakeValue(s *discord.Snowflake) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(s.String())
+}
@@ -0,0 +1,68 @@
+package role_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource_filters(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesDataSourceConfig_filters(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discord_roles.by_name", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.discord_roles.by_permission", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.discord_roles.by_permission", "roles.0.id", "discord_role.mod", "id"),
+					resource.TestCheckResourceAttr("data.discord_roles.by_permission", "roles.0.managed", "false"),
+					resource.TestCheckNoResourceAttr("data.discord_roles.by_permission", "roles.0.tags"),
+					resource.TestCheckResourceAttrSet("data.discord_roles.managed", "roles.0.tags.bot_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRolesDataSourceConfig_filters(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "mod" {
+  guild_id         = %[1]q
+  name             = "tf-acc-roles-mod"
+  permission_names = ["manage_messages"]
+}
+
+resource "discord_role" "member" {
+  guild_id = %[1]q
+  name     = "tf-acc-roles-member"
+}
+
+data "discord_roles" "by_name" {
+  guild_id   = %[1]q
+  name_regex = "^tf-acc-roles-"
+
+  depends_on = [discord_role.mod, discord_role.member]
+}
+
+data "discord_roles" "by_permission" {
+  guild_id   = %[1]q
+  name_regex = "^tf-acc-roles-"
+  permission = "manage_messages"
+
+  depends_on = [discord_role.mod, discord_role.member]
+}
+
+# The provider's own bot role is managed by its integration.
+data "discord_roles" "managed" {
+  guild_id = %[1]q
+  managed  = true
+}
+`, guildID)
+}
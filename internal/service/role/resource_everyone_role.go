@@ -0,0 +1,258 @@
+package role
+
+import (
+	"context"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &everyoneRoleResource{}
+	_ resource.ResourceWithConfigure   = &everyoneRoleResource{}
+	_ resource.ResourceWithImportState = &everyoneRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &everyoneRoleResource{}
+)
+
+// everyoneRoleResource is the resource implementation.
+type everyoneRoleResource struct {
+	client *discord.Client
+}
+
+// everyoneRoleResourceModel maps the resource schema to a Go struct.
+type everyoneRoleResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	GuildID         types.String `tfsdk:"guild_id"`
+	Permissions     types.String `tfsdk:"permissions"`
+	PermissionNames types.Set    `tfsdk:"permission_names"`
+	Mentionable     types.Bool   `tfsdk:"mentionable"`
+}
+
+// NewEveryoneRoleResource returns a new @everyone role resource.
+func NewEveryoneRoleResource() resource.Resource {
+	return &everyoneRoleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *everyoneRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_everyone_role"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *everyoneRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the resource.
+func (r *everyoneRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the @everyone role of a Discord guild. The role always exists, so creating this resource " +
+			"adopts it and destroying it resets the role to Discord's default permissions instead of deleting it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the @everyone role, which is the same as the guild ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.StringAttribute{
+				Description: "The permission bitfield for the @everyone role. Conflicts with permission_names.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("permission_names")),
+				},
+			},
+			"permission_names": schema.SetAttribute{
+				Description: "The permissions for the @everyone role as names from the discord_permission data source " +
+					"(e.g. view_channel, send_messages). Conflicts with permissions.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					common.PermissionNamesValidator(),
+				},
+			},
+			"mentionable": schema.BoolAttribute{
+				Description: "Whether the @everyone role can be mentioned by everyone.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan keeps permissions and permission_names in sync so plans show
+// named permission changes.
+func (r *everyoneRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(common.ModifyPermissionPlan(ctx, req.Config, &resp.Plan, path.Root("permissions"), path.Root("permission_names"))...)
+}
+
+// Create adopts the existing @everyone role and applies the configured settings.
+func (r *everyoneRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan everyoneRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := discord.Snowflake(plan.GuildID.ValueString())
+	role, err := r.client.ModifyGuildRole(ctx, guildID, guildID, buildEveryoneRoleParams(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Discord @everyone Role",
+			"Could not update @everyone role for guild ID "+plan.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapEveryoneRoleToState(ctx, role, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *everyoneRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state everyoneRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := r.client.GetGuildRoles(ctx, discord.Snowflake(state.GuildID.ValueString()))
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord @everyone Role",
+			"Could not read roles for guild ID "+state.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var found *discord.Role
+	for _, role := range roles {
+		if role.ID.String() == state.GuildID.ValueString() {
+			found = role
+			break
+		}
+	}
+
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(mapEveryoneRoleToState(ctx, found, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update modifies the @everyone role.
+func (r *everyoneRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan everyoneRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := discord.Snowflake(plan.GuildID.ValueString())
+	role, err := r.client.ModifyGuildRole(ctx, guildID, guildID, buildEveryoneRoleParams(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Discord @everyone Role",
+			"Could not update @everyone role for guild ID "+plan.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapEveryoneRoleToState(ctx, role, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete resets the @everyone role to Discord's defaults. The role itself
+// cannot be deleted.
+func (r *everyoneRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state everyoneRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions := discord.DefaultEveryonePermissions
+	mentionable := false
+	guildID := discord.Snowflake(state.GuildID.ValueString())
+	_, err := r.client.ModifyGuildRole(ctx, guildID, guildID, &discord.ModifyRoleParams{
+		Permissions: &permissions,
+		Mentionable: &mentionable,
+	})
+	if err != nil {
+		if discord.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Resetting Discord @everyone Role",
+			"Could not reset @everyone role for guild ID "+state.GuildID.ValueString()+": "+err.Error(),
+		)
+	}
+}
+
+// ImportState allows importing the @everyone role by guild ID.
+func (r *everyoneRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), req.ID)...)
+}
+
+// buildEveryoneRoleParams builds the modify parameters from the planned values.
+func buildEveryoneRoleParams(plan everyoneRoleResourceModel) *discord.ModifyRoleParams {
+	params := &discord.ModifyRoleParams{}
+	if !plan.Permissions.IsNull() && !plan.Permissions.IsUnknown() {
+		v := plan.Permissions.ValueString()
+		params.Permissions = &v
+	}
+	if !plan.Mentionable.IsNull() && !plan.Mentionable.IsUnknown() {
+		v := plan.Mentionable.ValueBool()
+		params.Mentionable = &v
+	}
+	return params
+}
+
+// mapEveryoneRoleToState maps a Discord Role API response to the Terraform state model.
+func mapEveryoneRoleToState(ctx context.Context, role *discord.Role, state *everyoneRoleResourceModel) diag.Diagnostics {
+	state.ID = types.StringValue(role.ID.String())
+	state.Permissions = types.StringValue(role.Permissions)
+	state.Mentionable = types.BoolValue(role.Mentionable)
+
+	permissionNames, diags := common.PermissionNamesSetFromBits(ctx, role.Permissions)
+	state.PermissionNames = permissionNames
+	return diags
+}
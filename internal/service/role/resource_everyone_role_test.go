@@ -0,0 +1,64 @@
+package role_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEveryoneRole_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccEveryoneRoleConfig_basic(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_everyone_role.test", "id", guildID),
+					resource.TestCheckResourceAttr("discord_everyone_role.test", "permissions", "3072"),
+					resource.TestCheckResourceAttr("discord_everyone_role.test", "mentionable", "false"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_everyone_role.test",
+				ImportState:       true,
+				ImportStateId:     guildID,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config: testAccEveryoneRoleConfig_names(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_everyone_role.test", "permissions", "66560"),
+					resource.TestCheckResourceAttr("discord_everyone_role.test", "permission_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEveryoneRoleConfig_basic(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_everyone_role" "test" {
+  guild_id    = %[1]q
+  permissions = "3072"
+  mentionable = false
+}
+`, guildID)
+}
+
+func testAccEveryoneRoleConfig_names(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_everyone_role" "test" {
+  guild_id         = %[1]q
+  permission_names = ["view_channel", "read_message_history"]
+}
+`, guildID)
+}
@@ -7,13 +7,19 @@ import (
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
 	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -21,6 +27,7 @@ var (
 	_ resource.Resource                = &roleResource{}
 	_ resource.ResourceWithConfigure   = &roleResource{}
 	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.ResourceWithModifyPlan  = &roleResource{}
 )
 
 // roleResource is the resource implementation.
@@ -30,17 +37,38 @@ type roleResource struct {
 
 // roleResourceModel maps the resource schema to a Go struct.
 type roleResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	GuildID      types.String `tfsdk:"guild_id"`
-	Name         types.String `tfsdk:"name"`
-	Permissions  types.String `tfsdk:"permissions"`
-	Color        types.Int64  `tfsdk:"color"`
-	Hoist        types.Bool   `tfsdk:"hoist"`
-	Icon         types.String `tfsdk:"icon"`
-	UnicodeEmoji types.String `tfsdk:"unicode_emoji"`
-	Mentionable  types.Bool   `tfsdk:"mentionable"`
-	Position     types.Int64  `tfsdk:"position"`
-	Managed      types.Bool   `tfsdk:"managed"`
+	ID              types.String `tfsdk:"id"`
+	GuildID         types.String `tfsdk:"guild_id"`
+	Name            types.String `tfsdk:"name"`
+	Permissions     types.String `tfsdk:"permissions"`
+	PermissionNames types.Set    `tfsdk:"permission_names"`
+	Color           types.Int64  `tfsdk:"color"`
+	Colors          types.Object `tfsdk:"colors"`
+	Hoist           types.Bool   `tfsdk:"hoist"`
+	Icon            types.String `tfsdk:"icon"`
+	IconFile        types.String `tfsdk:"icon_file"`
+	IconContentHash types.String `tfsdk:"icon_content_hash"`
+	IconHash        types.String `tfsdk:"icon_hash"`
+	UnicodeEmoji    types.String `tfsdk:"unicode_emoji"`
+	Mentionable     types.Bool   `tfsdk:"mentionable"`
+	Position        types.Int64  `tfsdk:"position"`
+	Managed         types.Bool   `tfsdk:"managed"`
+}
+
+// roleColorsModel maps the colors attribute.
+type roleColorsModel struct {
+	PrimaryColor   types.Int64 `tfsdk:"primary_color"`
+	SecondaryColor types.Int64 `tfsdk:"secondary_color"`
+	TertiaryColor  types.Int64 `tfsdk:"tertiary_color"`
+}
+
+// roleColorsAttrTypes returns the attribute types for the colors object.
+func roleColorsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"primary_color":   types.Int64Type,
+		"secondary_color": types.Int64Type,
+		"tertiary_color":  types.Int64Type,
+	}
 }
 
 // NewRoleResource returns a new role resource.
@@ -82,18 +110,57 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"permissions": schema.StringAttribute{
-				Description: "The permission bitfield for the role.",
+				Description: "The permission bitfield for the role. Conflicts with permission_names.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("permission_names")),
+				},
+			},
+			"permission_names": schema.SetAttribute{
+				Description: "The permissions for the role as names from the discord_permission data source " +
+					"(e.g. view_channel, send_messages). Conflicts with permissions.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					common.PermissionNamesValidator(),
+				},
 			},
 			"color": schema.Int64Attribute{
-				Description: "The RGB color value for the role (integer).",
+				Description: "The RGB color value for the role (integer). Conflicts with colors.",
 				Optional:    true,
 				Computed:    true,
 			},
+			"colors": schema.SingleNestedAttribute{
+				Description: "The role colors. Setting secondary_color makes a gradient role and setting tertiary_color " +
+					"makes a holographic role; both require the ENHANCED_ROLE_COLORS guild feature. Conflicts with color.",
+				Optional: true,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"primary_color": schema.Int64Attribute{
+						Description: "The primary RGB color value of the role.",
+						Required:    true,
+					},
+					"secondary_color": schema.Int64Attribute{
+						Description: "The secondary RGB color value of a gradient or holographic role.",
+						Optional:    true,
+					},
+					"tertiary_color": schema.Int64Attribute{
+						Description: fmt.Sprintf("The tertiary RGB color value of a holographic role. Holographic roles must use "+
+							"primary_color %d, secondary_color %d and tertiary_color %d.",
+							discord.HolographicPrimaryColor, discord.HolographicSecondaryColor, discord.HolographicTertiaryColor),
+						Optional: true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("color")),
+					roleColorsValidator{},
+				},
+			},
 			"hoist": schema.BoolAttribute{
 				Description: "Whether the role should be displayed separately in the sidebar.",
 				Optional:    true,
@@ -103,8 +170,29 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"icon": schema.StringAttribute{
-				Description: "The role icon as a base64-encoded image data URI.",
-				Optional:    true,
+				Description: "The role icon as a base64-encoded image data URI. Requires the ROLE_ICONS guild feature. " +
+					"Conflicts with icon_file.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("icon_file")),
+				},
+			},
+			"icon_file": schema.StringAttribute{
+				Description: "The path to a local image file to use as the role icon. Requires the ROLE_ICONS guild feature. " +
+					"Conflicts with icon.",
+				Optional: true,
+			},
+			"icon_content_hash": schema.StringAttribute{
+				Description: "The SHA-256 hash of the icon image set through icon or icon_file. " +
+					"The icon is only uploaded when this hash changes.",
+				Computed: true,
+			},
+			"icon_hash": schema.StringAttribute{
+				Description: "The icon hash Discord assigned to the uploaded role icon.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"unicode_emoji": schema.StringAttribute{
 				Description: "The role unicode emoji.",
@@ -134,6 +222,139 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// ModifyPlan keeps permissions and permission_names in sync so plans show
+// named permission changes.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(common.ModifyPermissionPlan(ctx, req.Config, &resp.Plan, path.Root("permissions"), path.Root("permission_names"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkColorsFeature(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.modifyIconPlan(ctx, req, resp)
+}
+
+// modifyIconPlan plans the icon content hash from the configured image so the
+// icon is only uploaded when the image itself changes, and checks that the
+// guild supports role icons.
+func (r *roleResource) modifyIconPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var icon, iconFile, stateHash, guildID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon"), &icon)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon_file"), &iconFile)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("guild_id"), &guildID)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("icon_content_hash"), &stateHash)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if icon.IsUnknown() || iconFile.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_content_hash"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_hash"), types.StringUnknown())...)
+		return
+	}
+
+	contentHash := types.StringNull()
+	if !icon.IsNull() || !iconFile.IsNull() {
+		_, hash, err := common.LoadImage(icon.ValueString(), iconFile.ValueString())
+		if err != nil {
+			attr := path.Root("icon")
+			if !iconFile.IsNull() {
+				attr = path.Root("icon_file")
+			}
+			resp.Diagnostics.AddAttributeError(attr, "Invalid Role Icon", err.Error())
+			return
+		}
+		contentHash = types.StringValue(hash)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_content_hash"), contentHash)...)
+	if contentHash.Equal(stateHash) {
+		return
+	}
+	if contentHash.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_hash"), types.StringNull())...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_hash"), types.StringUnknown())...)
+
+	if r.client == nil || guildID.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(r.requireGuildFeature(ctx, guildID.ValueString(), discord.GuildFeatureRoleIcons, path.Root("icon"),
+		"Role icons")...)
+}
+
+// requireGuildFeature returns an error diagnostic on the given attribute when
+// the guild does not have the feature.
+func (r *roleResource) requireGuildFeature(ctx context.Context, guildID, feature string, attr path.Path, what string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	guild, err := r.client.GetGuild(ctx, discord.Snowflake(guildID))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Discord Guild",
+			"Could not read guild ID "+guildID+": "+err.Error(),
+		)
+		return diags
+	}
+
+	for _, f := range guild.Features {
+		if f == feature {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		attr,
+		"Guild Feature Not Available",
+		fmt.Sprintf("%s require the %s guild feature, which guild %s does not have. "+
+			"The feature is unlocked by server boosts.", what, feature, guildID),
+	)
+	return diags
+}
+
+// checkColorsFeature reports an error at plan time when gradient or
+// holographic colors are being set in a guild without the feature.
+func (r *roleResource) checkColorsFeature(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var guildID types.String
+	var planColors, stateColors types.Object
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("guild_id"), &guildID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("colors"), &planColors)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("colors"), &stateColors)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if guildID.IsUnknown() || planColors.IsNull() || planColors.IsUnknown() || planColors.Equal(stateColors) {
+		return
+	}
+
+	colors, diags := expandRoleColors(ctx, planColors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || colors == nil || colors.SecondaryColor == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.requireGuildFeature(ctx, guildID.ValueString(), discord.GuildFeatureEnhancedRoleColors, path.Root("colors"),
+		"Gradient and holographic role colors")...)
+}
+
 // Create creates the role resource.
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan roleResourceModel
@@ -155,13 +376,25 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		v := int(plan.Color.ValueInt64())
 		params.Color = &v
 	}
+	if !plan.Colors.IsNull() && !plan.Colors.IsUnknown() {
+		colors, diags := expandRoleColors(ctx, plan.Colors)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		params.Colors = colors
+	}
 	if !plan.Hoist.IsNull() && !plan.Hoist.IsUnknown() {
 		v := plan.Hoist.ValueBool()
 		params.Hoist = &v
 	}
-	if !plan.Icon.IsNull() && !plan.Icon.IsUnknown() {
-		v := plan.Icon.ValueString()
-		params.Icon = &v
+	if !plan.IconContentHash.IsNull() {
+		uri, _, err := common.LoadImage(plan.Icon.ValueString(), plan.IconFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Role Icon", err.Error())
+			return
+		}
+		params.Icon = &uri
 	}
 	if !plan.UnicodeEmoji.IsNull() && !plan.UnicodeEmoji.IsUnknown() {
 		v := plan.UnicodeEmoji.ValueString()
@@ -201,7 +434,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Re-read to get the final state (position may have shifted).
-	mapRoleToState(role, &plan)
+	resp.Diagnostics.Append(mapRoleToState(ctx, role, &plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -242,7 +475,13 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	mapRoleToState(found, &state)
+	// An icon changed outside of Terraform no longer matches the configured
+	// image, so forget its content hash to plan a re-upload.
+	previousIconHash := state.IconHash
+	resp.Diagnostics.Append(mapRoleToState(ctx, found, &state)...)
+	if !previousIconHash.IsNull() && !state.IconHash.Equal(previousIconHash) {
+		state.IconContentHash = types.StringNull()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -273,13 +512,29 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		v := int(plan.Color.ValueInt64())
 		params.Color = &v
 	}
+	if !plan.Colors.IsNull() && !plan.Colors.IsUnknown() {
+		colors, diags := expandRoleColors(ctx, plan.Colors)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		params.Colors = colors
+	}
 	if !plan.Hoist.IsNull() && !plan.Hoist.IsUnknown() {
 		v := plan.Hoist.ValueBool()
 		params.Hoist = &v
 	}
-	if !plan.Icon.IsNull() {
-		v := plan.Icon.ValueString()
-		params.Icon = &v
+	if !plan.IconContentHash.Equal(state.IconContentHash) {
+		if plan.IconContentHash.IsNull() {
+			params.Icon = &discord.NullString{Null: true}
+		} else {
+			uri, _, err := common.LoadImage(plan.Icon.ValueString(), plan.IconFile.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Role Icon", err.Error())
+				return
+			}
+			params.Icon = &discord.NullString{Value: uri}
+		}
 	}
 	if !plan.UnicodeEmoji.IsNull() {
 		v := plan.UnicodeEmoji.ValueString()
@@ -321,7 +576,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	plan.ID = state.ID
 	plan.GuildID = state.GuildID
-	mapRoleToState(role, &plan)
+	resp.Diagnostics.Append(mapRoleToState(ctx, role, &plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -364,7 +619,7 @@ func (r *roleResource) ImportState(ctx context.Context, req resource.ImportState
 }
 
 // mapRoleToState maps a Discord Role API response to the Terraform state model.
-func mapRoleToState(role *discord.Role, state *roleResourceModel) {
+func mapRoleToState(ctx context.Context, role *discord.Role, state *roleResourceModel) diag.Diagnostics {
 	state.ID = types.StringValue(role.ID.String())
 	state.Name = types.StringValue(role.Name)
 	state.Permissions = types.StringValue(role.Permissions)
@@ -375,13 +630,108 @@ func mapRoleToState(role *discord.Role, state *roleResourceModel) {
 	state.Managed = types.BoolValue(role.Managed)
 
 	if role.Icon != nil {
-		state.Icon = types.StringValue(*role.Icon)
+		state.IconHash = types.StringValue(*role.Icon)
 	} else {
-		state.Icon = types.StringNull()
+		state.IconHash = types.StringNull()
 	}
 	if role.UnicodeEmoji != nil {
 		state.UnicodeEmoji = types.StringValue(*role.UnicodeEmoji)
 	} else {
 		state.UnicodeEmoji = types.StringNull()
 	}
+
+	var diags diag.Diagnostics
+	state.Colors, diags = flattenRoleColors(role)
+
+	permissionNames, d := common.PermissionNamesSetFromBits(ctx, role.Permissions)
+	diags.Append(d...)
+	state.PermissionNames = permissionNames
+	return diags
+}
+
+// expandRoleColors converts the colors object to the Discord API representation.
+func expandRoleColors(ctx context.Context, obj types.Object) (*discord.RoleColors, diag.Diagnostics) {
+	var m roleColorsModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{})
+	if diags.HasError() || m.PrimaryColor.IsUnknown() {
+		return nil, diags
+	}
+
+	colors := &discord.RoleColors{
+		PrimaryColor: int(m.PrimaryColor.ValueInt64()),
+	}
+	if !m.SecondaryColor.IsNull() && !m.SecondaryColor.IsUnknown() {
+		v := int(m.SecondaryColor.ValueInt64())
+		colors.SecondaryColor = &v
+	}
+	if !m.TertiaryColor.IsNull() && !m.TertiaryColor.IsUnknown() {
+		v := int(m.TertiaryColor.ValueInt64())
+		colors.TertiaryColor = &v
+	}
+	return colors, diags
+}
+
+// flattenRoleColors converts a role's colors to the colors object. Roles
+// without a colors object report their single color as the primary color.
+func flattenRoleColors(role *discord.Role) (types.Object, diag.Diagnostics) {
+	primary := types.Int64Value(int64(role.Color))
+	secondary := types.Int64Null()
+	tertiary := types.Int64Null()
+
+	if role.Colors != nil {
+		primary = types.Int64Value(int64(role.Colors.PrimaryColor))
+		if role.Colors.SecondaryColor != nil {
+			secondary = types.Int64Value(int64(*role.Colors.SecondaryColor))
+		}
+		if role.Colors.TertiaryColor != nil {
+			tertiary = types.Int64Value(int64(*role.Colors.TertiaryColor))
+		}
+	}
+
+	return types.ObjectValue(roleColorsAttrTypes(), map[string]attr.Value{
+		"primary_color":   primary,
+		"secondary_color": secondary,
+		"tertiary_color":  tertiary,
+	})
+}
+
+// roleColorsValidator validates that a tertiary color is only used for the
+// holographic style, which Discord restricts to fixed values.
+type roleColorsValidator struct{}
+
+func (v roleColorsValidator) Description(_ context.Context) string {
+	return "tertiary_color requires the holographic color values"
+}
+
+func (v roleColorsValidator) MarkdownDescription(_ context.Context) string {
+	return "tertiary_color requires the holographic color values"
+}
+
+func (v roleColorsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var m roleColorsModel
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if m.TertiaryColor.IsNull() || m.TertiaryColor.IsUnknown() ||
+		m.PrimaryColor.IsUnknown() || m.SecondaryColor.IsUnknown() {
+		return
+	}
+
+	if m.PrimaryColor.ValueInt64() != discord.HolographicPrimaryColor ||
+		m.SecondaryColor.IsNull() || m.SecondaryColor.ValueInt64() != discord.HolographicSecondaryColor ||
+		m.TertiaryColor.ValueInt64() != discord.HolographicTertiaryColor {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Holographic Role Colors",
+			fmt.Sprintf("tertiary_color is only allowed for holographic roles, which must use primary_color %d, "+
+				"secondary_color %d and tertiary_color %d.",
+				discord.HolographicPrimaryColor, discord.HolographicSecondaryColor, discord.HolographicTertiaryColor),
+		)
+	}
 }
@@ -0,0 +1,435 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/discord"
+	"github.com/edw1nzhao/terraform-provider-discord/internal/service/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &roleOrderResource{}
+	_ resource.ResourceWithConfigure   = &roleOrderResource{}
+	_ resource.ResourceWithImportState = &roleOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &roleOrderResource{}
+)
+
+// roleOrderResource is the resource implementation.
+type roleOrderResource struct {
+	client *discord.Client
+}
+
+// roleOrderResourceModel maps the resource schema to a Go struct.
+type roleOrderResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	GuildID types.String `tfsdk:"guild_id"`
+	RoleIDs types.List   `tfsdk:"role_ids"`
+}
+
+// rolePositionChange is a role that must move to a new position.
+type rolePositionChange struct {
+	Role     *discord.Role
+	Position int
+}
+
+// NewRoleOrderResource returns a new role order resource.
+func NewRoleOrderResource() resource.Resource {
+	return &roleOrderResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_order"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = common.ClientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Schema defines the schema for the resource.
+func (r *roleOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order of a guild's role hierarchy in a single request. " +
+			"The listed roles are rearranged among the positions they already hold, so roles that are not listed, " +
+			"@everyone and integration-managed roles keep their place. Importing lists every orderable role. " +
+			"Do not set position on discord_role for roles ordered by this resource. " +
+			"Destroying this resource leaves the roles in their current order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild whose roles are ordered.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_ids": schema.ListAttribute{
+				Description: "The role IDs ordered from the top of the hierarchy to the bottom.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan validates the requested order against the guild's current roles
+// and refuses to move roles at or above the bot's highest role.
+func (r *roleOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan roleOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.GuildID.IsUnknown() || plan.RoleIDs.IsUnknown() {
+		return
+	}
+
+	var elems []types.String
+	resp.Diagnostics.Append(plan.RoleIDs.ElementsAs(ctx, &elems, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleIDs := make([]discord.Snowflake, 0, len(elems))
+	for _, e := range elems {
+		if e.IsUnknown() {
+			// Roles created in the same apply are checked during apply.
+			return
+		}
+		roleIDs = append(roleIDs, discord.Snowflake(e.ValueString()))
+	}
+
+	guildID := discord.Snowflake(plan.GuildID.ValueString())
+	roles, err := r.client.GetGuildRoles(ctx, guildID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Roles",
+			"Could not read roles for guild ID "+plan.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	changes, err := planRolePositions(guildID, roles, roleIDs)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_ids"), "Invalid Role Order", err.Error())
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	botTop, isOwner, err := r.botHighestRolePosition(ctx, guildID, roles)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Bot Member",
+			"Could not determine the bot's highest role in guild ID "+plan.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if isOwner {
+		return
+	}
+
+	for _, c := range changes {
+		if c.Role.Position >= botTop || c.Position >= botTop {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_ids"),
+				"Role Above Bot's Highest Role",
+				fmt.Sprintf("Role %s (%s) cannot be moved from position %d to %d because the bot's highest role is at position %d. "+
+					"Move the bot's role higher or reorder this role manually.",
+					c.Role.Name, c.Role.ID, c.Role.Position, c.Position, botTop),
+			)
+		}
+	}
+}
+
+// Create applies the role order.
+func (r *roleOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.GuildID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := discord.Snowflake(state.GuildID.ValueString())
+	roles, err := r.client.GetGuildRoles(ctx, guildID)
+	if err != nil {
+		if discord.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Discord Roles",
+			"Could not read roles for guild ID "+state.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only the roles in state are read back, so that a configuration listing
+	// some of the roles does not show the others as drift. Imports start with
+	// no roles in state and read every orderable role.
+	var managed map[string]bool
+	if !state.RoleIDs.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(state.RoleIDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		managed = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			managed[id] = true
+		}
+	}
+
+	ordered := orderableRoles(guildID, roles)
+	roleIDs := make([]string, 0, len(ordered))
+	for _, role := range ordered {
+		if managed == nil || managed[role.ID.String()] {
+			roleIDs = append(roleIDs, role.ID.String())
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, roleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(guildID.String())
+	state.RoleIDs = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update applies the new role order.
+func (r *roleOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.GuildID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the resource from state. The roles keep their current order.
+func (r *roleOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState allows importing the role order by guild ID.
+func (r *roleOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), req.ID)...)
+}
+
+// applyOrder submits every required position change in one request.
+func (r *roleOrderResource) applyOrder(ctx context.Context, plan *roleOrderResourceModel, diags *diag.Diagnostics) {
+	var ids []string
+	diags.Append(plan.RoleIDs.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return
+	}
+	roleIDs := make([]discord.Snowflake, 0, len(ids))
+	for _, id := range ids {
+		roleIDs = append(roleIDs, discord.Snowflake(id))
+	}
+
+	guildID := discord.Snowflake(plan.GuildID.ValueString())
+	roles, err := r.client.GetGuildRoles(ctx, guildID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Discord Roles",
+			"Could not read roles for guild ID "+plan.GuildID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	changes, err := planRolePositions(guildID, roles, roleIDs)
+	if err != nil {
+		diags.AddAttributeError(path.Root("role_ids"), "Invalid Role Order", err.Error())
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	positions := make([]*discord.RolePosition, 0, len(changes))
+	for _, c := range changes {
+		pos := c.Position
+		positions = append(positions, &discord.RolePosition{
+			ID:       c.Role.ID,
+			Position: &pos,
+		})
+	}
+
+	_, err = r.client.ModifyGuildRolePositions(ctx, guildID, positions)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Discord Role Positions",
+			"Could not reorder roles for guild ID "+plan.GuildID.ValueString()+": "+err.Error(),
+		)
+	}
+}
+
+// botHighestRolePosition returns the position of the bot's highest role in
+// the guild and whether the bot owns the guild.
+func (r *roleOrderResource) botHighestRolePosition(ctx context.Context, guildID discord.Snowflake, roles []*discord.Role) (int, bool, error) {
+	user, err := r.client.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	guild, err := r.client.GetGuild(ctx, guildID)
+	if err != nil {
+		return 0, false, err
+	}
+	if guild.OwnerID == user.ID {
+		return 0, true, nil
+	}
+
+	member, err := r.client.GetGuildMember(ctx, guildID, user.ID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	positions := make(map[discord.Snowflake]int, len(roles))
+	for _, role := range roles {
+		positions[role.ID] = role.Position
+	}
+
+	top := 0
+	for _, id := range member.Roles {
+		if pos, ok := positions[id]; ok && pos > top {
+			top = pos
+		}
+	}
+	return top, false, nil
+}
+
+// orderableRoles returns the roles that can be ordered, from the top of the
+// hierarchy to the bottom. @everyone and integration-managed roles are
+// excluded.
+func orderableRoles(guildID discord.Snowflake, roles []*discord.Role) []*discord.Role {
+	result := make([]*discord.Role, 0, len(roles))
+	for _, role := range sortRolesTopDown(guildID, roles) {
+		if !role.Managed {
+			result = append(result, role)
+		}
+	}
+	return result
+}
+
+// sortRolesTopDown returns the guild's roles except @everyone, from the top of
+// the hierarchy to the bottom. Roles sharing a position are ordered by ID.
+func sortRolesTopDown(guildID discord.Snowflake, roles []*discord.Role) []*discord.Role {
+	sorted := make([]*discord.Role, 0, len(roles))
+	for _, role := range roles {
+		if role.ID != guildID {
+			sorted = append(sorted, role)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position > sorted[j].Position
+		}
+		a, b := sorted[i].ID.String(), sorted[j].ID.String()
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	})
+	return sorted
+}
+
+// planRolePositions computes the position changes needed so the listed roles
+// appear in the given top-to-bottom order. The listed roles are rearranged
+// among the hierarchy slots they already occupy, so unlisted and managed roles
+// keep their place.
+func planRolePositions(guildID discord.Snowflake, roles []*discord.Role, roleIDs []discord.Snowflake) ([]rolePositionChange, error) {
+	byID := make(map[discord.Snowflake]*discord.Role, len(roles))
+	for _, role := range roles {
+		byID[role.ID] = role
+	}
+
+	listed := make(map[discord.Snowflake]bool, len(roleIDs))
+	for _, id := range roleIDs {
+		if id == guildID {
+			return nil, fmt.Errorf("the @everyone role (%s) is always at the bottom of the hierarchy and cannot be ordered", id)
+		}
+		role, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("role %s does not exist in guild %s", id, guildID)
+		}
+		if role.Managed {
+			return nil, fmt.Errorf("role %s (%s) is managed by an integration and cannot be ordered", role.Name, id)
+		}
+		listed[id] = true
+	}
+
+	ordered := sortRolesTopDown(guildID, roles)
+	next := 0
+	for i, role := range ordered {
+		if listed[role.ID] {
+			ordered[i] = byID[roleIDs[next]]
+			next++
+		}
+	}
+
+	var changes []rolePositionChange
+	for i, role := range ordered {
+		pos := len(ordered) - i
+		if listed[role.ID] && role.Position != pos {
+			changes = append(changes, rolePositionChange{Role: role, Position: pos})
+		}
+	}
+	return changes, nil
+}
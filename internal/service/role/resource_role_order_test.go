@@ -0,0 +1,134 @@
+package role_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// TestAccRoleOrder_basic expects the test guild to contain no unmanaged roles
+// other than the ones created here, since an import lists every orderable
+// role.
+func TestAccRoleOrder_basic(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRoleOrderConfig_basic(guildID, "first", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_role_order.test", "id", guildID),
+					resource.TestCheckResourceAttr("discord_role_order.test", "role_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("discord_role_order.test", "role_ids.0", "discord_role.first", "id"),
+					resource.TestCheckResourceAttrPair("discord_role_order.test", "role_ids.1", "discord_role.second", "id"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "discord_role_order.test",
+				ImportState:       true,
+				ImportStateId:     guildID,
+				ImportStateVerify: true,
+			},
+			// Update: swap the roles
+			{
+				Config: testAccRoleOrderConfig_basic(guildID, "second", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_role_order.test", "role_ids.0", "discord_role.second", "id"),
+					resource.TestCheckResourceAttrPair("discord_role_order.test", "role_ids.1", "discord_role.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleOrderConfig_basic(guildID, top, bottom string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "first" {
+  guild_id = %[1]q
+  name     = "tf-acc-order-first"
+}
+
+resource "discord_role" "second" {
+  guild_id = %[1]q
+  name     = "tf-acc-order-second"
+}
+
+resource "discord_role_order" "test" {
+  guild_id = %[1]q
+
+  role_ids = [
+    discord_role.%[2]s.id,
+    discord_role.%[3]s.id,
+  ]
+}
+`, guildID, top, bottom)
+}
+
+// TestAccRoleOrder_subset orders some of the guild's roles and expects the
+// unlisted roles not to show up as drift.
+func TestAccRoleOrder_subset(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleOrderConfig_subset(guildID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_role_order.test", "role_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("discord_role_order.test", "role_ids.0", "discord_role.second", "id"),
+					resource.TestCheckResourceAttrPair("discord_role_order.test", "role_ids.1", "discord_role.first", "id"),
+				),
+			},
+			// Planning again must not try to reorder the roles.
+			{
+				Config:   testAccRoleOrderConfig_subset(guildID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccRoleOrderConfig_subset(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "first" {
+  guild_id = %[1]q
+  name     = "tf-acc-order-first"
+}
+
+resource "discord_role" "second" {
+  guild_id = %[1]q
+  name     = "tf-acc-order-second"
+}
+
+resource "discord_role" "unlisted" {
+  guild_id = %[1]q
+  name     = "tf-acc-order-unlisted"
+}
+
+resource "discord_role_order" "test" {
+  guild_id = %[1]q
+
+  role_ids = [
+    discord_role.second.id,
+    discord_role.first.id,
+  ]
+
+  depends_on = [discord_role.unlisted]
+}
+`, guildID)
+}
@@ -1,8 +1,10 @@
 package role_test
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/edw1nzhao/terraform-provider-discord/internal/acctest"
@@ -46,6 +48,102 @@ func TestAccRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccRole_permissionNames(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRoleConfig_permissionNames(guildID, `["view_channel", "send_messages"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_role.test", "permissions", "3072"),
+					resource.TestCheckResourceAttr("discord_role.test", "permission_names.#", "2"),
+				),
+			},
+			// Update
+			{
+				Config: testAccRoleConfig_permissionNames(guildID, `["view_channel"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_role.test", "permissions", "1024"),
+					resource.TestCheckResourceAttr("discord_role.test", "permission_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("discord_role.test", "permission_names.*", "view_channel"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRole_colors(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_colors(guildID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_role.test", "colors.primary_color", "3447003"),
+					resource.TestCheckNoResourceAttr("discord_role.test", "colors.secondary_color"),
+					resource.TestCheckResourceAttr("discord_role.test", "color", "3447003"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRoleIconPNG is a 1x1 PNG image.
+const testAccRoleIconPNG = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
+
+// TestAccRole_iconFile requires a test guild with the ROLE_ICONS feature.
+func TestAccRole_iconFile(t *testing.T) {
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+
+	data, err := base64.StdEncoding.DecodeString(testAccRoleIconPNG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iconFile := filepath.Join(t.TempDir(), "icon.png")
+	if err := os.WriteFile(iconFile, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var iconHash string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckGuild(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_iconFile(guildID, iconFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discord_role.test", "icon_content_hash"),
+					resource.TestCheckResourceAttrWith("discord_role.test", "icon_hash", func(v string) error {
+						iconHash = v
+						return nil
+					}),
+				),
+			},
+			// The same image as a data URI keeps the uploaded icon.
+			{
+				Config: testAccRoleConfig_iconDataURI(guildID, "data:image/png;base64,"+testAccRoleIconPNG),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("discord_role.test", "icon_hash", func(v string) error {
+						if v != iconHash {
+							return fmt.Errorf("expected icon_hash %q to be unchanged, got %q", iconHash, v)
+						}
+						return nil
+					}),
+					resource.TestCheckNoResourceAttr("discord_role.test", "icon_file"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRoleConfig_basic(guildID string) string {
 	return fmt.Sprintf(`
 resource "discord_role" "test" {
@@ -66,3 +164,46 @@ resource "discord_role" "test" {
 }
 `, guildID)
 }
+
+func testAccRoleConfig_permissionNames(guildID, permissionNames string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "test" {
+  guild_id         = %[1]q
+  name             = "tf-acc-test-role-names"
+  permission_names = %[2]s
+}
+`, guildID, permissionNames)
+}
+
+func testAccRoleConfig_colors(guildID string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "test" {
+  guild_id = %[1]q
+  name     = "tf-acc-test-role-colors"
+
+  colors = {
+    primary_color = 3447003
+  }
+}
+`, guildID)
+}
+
+func testAccRoleConfig_iconFile(guildID, iconFile string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "test" {
+  guild_id  = %[1]q
+  name      = "tf-acc-test-role-icon"
+  icon_file = %[2]q
+}
+`, guildID, iconFile)
+}
+
+func testAccRoleConfig_iconDataURI(guildID, icon string) string {
+	return fmt.Sprintf(`
+resource "discord_role" "test" {
+  guild_id = %[1]q
+  name     = "tf-acc-test-role-icon"
+  icon     = %[2]q
+}
+`, guildID, icon)
+}